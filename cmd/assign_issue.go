@@ -5,12 +5,11 @@ import (
 	"fmt"
 	"strconv"
 
-	"github.com/shurcooL/githubv4"
 	"github.com/spf13/cobra"
-	"golang.org/x/oauth2"
 
 	"github.com/skarlso/caretaker/pkg/assignissue"
 	"github.com/skarlso/caretaker/pkg/client"
+	"github.com/skarlso/caretaker/pkg/config"
 	"github.com/skarlso/caretaker/pkg/logger"
 )
 
@@ -28,23 +27,27 @@ func CreateAssignIssueCommand(rootArgs *rootArgsStruct) *cobra.Command {
 func assignIssueRunE(rootArgs *rootArgsStruct) func(cmd *cobra.Command, args []string) error {
 	return func(_ *cobra.Command, _ []string) error {
 		ctx := context.Background()
-		ts := oauth2.StaticTokenSource(
-			&oauth2.Token{AccessToken: rootArgs.token},
-		)
-		tc := oauth2.NewClient(ctx, ts)
-		gclient := githubv4.NewClient(tc)
-
 		// setup logger
 		var log logger.Logger = &logger.QuiteLogger{}
 		if rootArgs.verbose {
 			log = &logger.VerboseLogger{}
 		}
 
+		gclient, err := newGraphQLClient(ctx, log, rootArgs)
+		if err != nil {
+			return err
+		}
+
 		log.Log("running assign command")
 
-		projectNumber, err := strconv.Atoi(rootArgs.projectNumber)
+		cfg, err := config.Load(rootArgs.config)
 		if err != nil {
-			return fmt.Errorf("failed to convert project number: %w", err)
+			return err
+		}
+
+		assignments, err := collectAssignments(rootArgs, cfg)
+		if err != nil {
+			return err
 		}
 
 		issueNumber, err := strconv.Atoi(rootArgs.issueNumber)
@@ -52,14 +55,27 @@ func assignIssueRunE(rootArgs *rootArgsStruct) func(cmd *cobra.Command, args []s
 			return fmt.Errorf("failed to convert issue number: %w", err)
 		}
 
+		isOrganization := parseOptionalBool(rootArgs.isOrganization)
+		projectOwnerIsOrganization := parseOptionalBool(rootArgs.projectOwnerIsOrg)
+
+		queryCache, err := newCache(log, rootArgs)
+		if err != nil {
+			return err
+		}
+		defer saveCache(log, queryCache)
+
 		client := client.NewCaretaker(log, gclient, client.Options{
-			Repo:           rootArgs.repo,
-			Owner:          rootArgs.owner,
-			IsOrganization: rootArgs.isOrganization != "",
+			Repo:                       rootArgs.repo,
+			Owner:                      rootArgs.owner,
+			IsOrganization:             isOrganization,
+			ProjectOwner:               rootArgs.projectOwner,
+			ProjectOwnerIsOrganization: projectOwnerIsOrganization,
+			ProjectID:                  rootArgs.projectID,
+			Cache:                      queryCache,
 		})
 		assigner := assignissue.NewAssignIssueAction(log, client, assignissue.Options{
-			ProjectNumber: projectNumber,
-			IssueNumber:   issueNumber,
+			IssueNumber: issueNumber,
+			Assignments: assignments,
 		})
 
 		return assigner.Assign(ctx)
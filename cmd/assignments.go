@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/skarlso/caretaker/pkg/assignissue"
+	"github.com/skarlso/caretaker/pkg/config"
+)
+
+// collectAssignments gathers the assignments from the configuration file and the --project-number,
+// --status-option and --field-values flags, in that order. The projects given by flags have no conditions.
+func collectAssignments(rootArgs *rootArgsStruct, cfg *config.Config) ([]assignissue.Assignment, error) {
+	fields, err := splitMap(rootArgs.fieldValues)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse field values: %w", err)
+	}
+
+	assignments := make([]assignissue.Assignment, 0, len(cfg.Assignments))
+
+	for _, a := range cfg.Assignments {
+		assignment := assignissue.Assignment{
+			ProjectNumber: a.Project,
+			Labels:        a.Labels,
+			Status:        a.Status,
+			Fields:        a.Fields,
+		}
+
+		if a.Title != "" {
+			if assignment.Title, err = regexp.Compile(a.Title); err != nil {
+				return nil, fmt.Errorf("invalid title pattern of the assignment to project %d: %w", a.Project, err)
+			}
+		}
+
+		assignments = append(assignments, assignment)
+	}
+
+	for _, value := range splitList(rootArgs.projectNumber) {
+		projectNumber, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert project number: %w", err)
+		}
+
+		// zero is the default of the flag; it only addresses a project together with --project-id
+		if projectNumber == 0 && rootArgs.projectID == "" {
+			continue
+		}
+
+		assignments = append(assignments, assignissue.Assignment{
+			ProjectNumber: projectNumber,
+			Status:        rootArgs.statusOption,
+			Fields:        fields,
+		})
+	}
+
+	if len(assignments) == 0 {
+		return nil, fmt.Errorf("no projects defined, set --project-number, --project-id or assignments in the configuration")
+	}
+
+	return assignments, nil
+}
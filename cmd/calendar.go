@@ -0,0 +1,40 @@
+package cmd
+
+import (
+	"github.com/skarlso/caretaker/pkg/calendar"
+	"github.com/skarlso/caretaker/pkg/config"
+)
+
+// newCalendar creates the working calendar from the configuration file and the flags. Flags take precedence.
+// It returns nil if no calendar is configured, unless any of the intervals is defined in business days.
+func newCalendar(rootArgs *rootArgsStruct, cfg *config.Config, intervals ...string) (*calendar.Calendar, error) {
+	opts := cfg.Calendar
+
+	if rootArgs.workingDays != "" {
+		opts.WorkingDays = rootArgs.workingDays
+	}
+
+	if rootArgs.workingHours != "" {
+		opts.WorkingHours = rootArgs.workingHours
+	}
+
+	if rootArgs.timeZone != "" {
+		opts.TimeZone = rootArgs.timeZone
+	}
+
+	if rootArgs.holidays != "" {
+		opts.Holidays = splitList(rootArgs.holidays)
+	}
+
+	if opts.IsZero() {
+		for _, interval := range intervals {
+			if calendar.IsBusinessDuration(interval) {
+				return calendar.Default(), nil
+			}
+		}
+
+		return nil, nil
+	}
+
+	return calendar.New(opts)
+}
@@ -0,0 +1,88 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/shurcooL/githubv4"
+	"golang.org/x/oauth2"
+
+	"github.com/skarlso/caretaker/pkg/cache"
+	"github.com/skarlso/caretaker/pkg/calendar"
+	"github.com/skarlso/caretaker/pkg/client"
+	"github.com/skarlso/caretaker/pkg/logger"
+	"github.com/skarlso/caretaker/pkg/recording"
+)
+
+// newGraphQLClient creates the GitHub GraphQL client authenticated with the token. If --github-url is set,
+// the client talks to that endpoint instead of github.com. With --record, every request and response is
+// written to the directory, and with --replay, the responses recorded into the directory are served instead
+// of talking to GitHub. If --batch-size is larger than one, concurrent mutations are combined into batches.
+func newGraphQLClient(ctx context.Context, log logger.Logger, rootArgs *rootArgsStruct) (client.GraphQLClient, error) {
+	if rootArgs.record != "" && rootArgs.replay != "" {
+		return nil, errors.New("--record and --replay can't be combined, replayed responses aren't recorded")
+	}
+
+	ts := oauth2.StaticTokenSource(
+		&oauth2.Token{AccessToken: rootArgs.token},
+	)
+	tc := oauth2.NewClient(ctx, ts)
+
+	if rootArgs.record != "" {
+		recorder, err := recording.NewRecorder(log, rootArgs.record, tc.Transport, rootArgs.token)
+		if err != nil {
+			return nil, err
+		}
+
+		tc.Transport = recorder
+	}
+
+	var gclient client.GraphQLClient = githubv4.NewClient(tc)
+	if rootArgs.githubURL != "" {
+		gclient = githubv4.NewEnterpriseClient(rootArgs.githubURL, tc)
+	}
+
+	if rootArgs.replay != "" {
+		replayClient, _, err := recording.NewReplayClient(rootArgs.replay)
+		if err != nil {
+			return nil, err
+		}
+
+		gclient = replayClient
+	}
+
+	batchSize, err := parseCount("batch-size", rootArgs.batchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	if batchSize > 1 {
+		gclient = client.NewBatchingClient(gclient, batchSize, client.DefaultBatchWindow)
+	}
+
+	return gclient, nil
+}
+
+// newCache creates the cache persisted to --cache-file. Without a file, nil is returned and
+// the client caches in memory for the run.
+func newCache(log logger.Logger, rootArgs *rootArgsStruct) (*cache.Cache, error) {
+	if rootArgs.cacheFile == "" {
+		return nil, nil
+	}
+
+	ttl, err := calendar.ParseDuration(rootArgs.cacheTTL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse cache ttl: %w", err)
+	}
+
+	return cache.Load(log, rootArgs.cacheFile, ttl)
+}
+
+// saveCache persists the cache. A failure doesn't fail the command, the next run just starts with
+// fewer cached entries.
+func saveCache(log logger.Logger, c *cache.Cache) {
+	if err := c.Save(); err != nil {
+		log.Log("failed to save cache: %s", err)
+	}
+}
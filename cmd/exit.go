@@ -0,0 +1,32 @@
+package cmd
+
+import (
+	"errors"
+
+	"github.com/skarlso/caretaker/pkg/client"
+)
+
+// Exit codes of the commands, so workflows can tell failures apart, for example, to retry rate limited runs.
+const (
+	ExitFailure     = 1
+	ExitForbidden   = 3
+	ExitNotFound    = 4
+	ExitRateLimited = 5
+)
+
+// ExitCode returns the process exit code for the error returned by a command. If a run failed for
+// several reasons, rate limiting takes precedence over missing permissions over missing objects.
+func ExitCode(err error) int {
+	switch {
+	case err == nil:
+		return 0
+	case errors.Is(err, client.ErrRateLimited):
+		return ExitRateLimited
+	case errors.Is(err, client.ErrForbidden):
+		return ExitForbidden
+	case errors.Is(err, client.ErrNotFound):
+		return ExitNotFound
+	default:
+		return ExitFailure
+	}
+}
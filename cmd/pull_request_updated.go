@@ -5,9 +5,7 @@ import (
 	"fmt"
 	"strconv"
 
-	"github.com/shurcooL/githubv4"
 	"github.com/spf13/cobra"
-	"golang.org/x/oauth2"
 
 	"github.com/skarlso/caretaker/pkg/client"
 	"github.com/skarlso/caretaker/pkg/logger"
@@ -29,18 +27,17 @@ func CreatePullRequestUpdatedCommand(rootArgs *rootArgsStruct) *cobra.Command {
 func pullRequestUpdatedRunE(rootArgs *rootArgsStruct) func(cmd *cobra.Command, args []string) error {
 	return func(_ *cobra.Command, _ []string) error {
 		ctx := context.Background()
-		ts := oauth2.StaticTokenSource(
-			&oauth2.Token{AccessToken: rootArgs.token},
-		)
-		tc := oauth2.NewClient(ctx, ts)
-		gclient := githubv4.NewClient(tc)
-
 		// setup logger
 		var log logger.Logger = &logger.QuiteLogger{}
 		if rootArgs.verbose {
 			log = &logger.VerboseLogger{}
 		}
 
+		gclient, err := newGraphQLClient(ctx, log, rootArgs)
+		if err != nil {
+			return err
+		}
+
 		log.Log("running pull request updated command")
 
 		prNumber, err := strconv.Atoi(rootArgs.pullRequestNumber)
@@ -48,15 +45,34 @@ func pullRequestUpdatedRunE(rootArgs *rootArgsStruct) func(cmd *cobra.Command, a
 			return fmt.Errorf("failed to convert pull request number: %w", err)
 		}
 
+		concurrency, err := parseCount("concurrency", rootArgs.concurrency)
+		if err != nil {
+			return err
+		}
+
+		isOrganization := parseOptionalBool(rootArgs.isOrganization)
+
+		queryCache, err := newCache(log, rootArgs)
+		if err != nil {
+			return err
+		}
+		defer saveCache(log, queryCache)
+
 		client := client.NewCaretaker(log, gclient, client.Options{
-			Repo:  rootArgs.repo,
-			Owner: rootArgs.owner,
+			Repo:                rootArgs.repo,
+			Owner:               rootArgs.owner,
+			IsOrganization:      isOrganization,
+			MoveClosed:          rootArgs.moveClosed != "",
+			Cache:               queryCache,
+			CreateMissingLabels: rootArgs.createMissingLabels != "",
+			Strict:              rootArgs.strict != "",
 		})
 		updater := pullrequestupdated.NewUpdater(log, client, pullrequestupdated.Options{
 			PullRequestNumber: prNumber,
 			StatusName:        rootArgs.statusOption,
 			ScanLabel:         rootArgs.pullRequestProcessedLabel,
 			NoComment:         rootArgs.disableComments != "",
+			Concurrency:       concurrency,
 		})
 
 		return updater.PullRequestUpdated(ctx)
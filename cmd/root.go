@@ -3,6 +3,8 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
 )
@@ -18,6 +20,10 @@ type rootArgsStruct struct {
 	pullRequestNumber         string
 	issueNumber               string
 	projectNumber             string
+	projectOwner              string
+	projectOwnerIsOrg         string
+	projectID                 string
+	fieldValues               string
 	statusOption              string
 	scanInterval              string
 	pullRequestProcessedLabel string
@@ -25,9 +31,40 @@ type rootArgsStruct struct {
 	disableComments           string
 	commentBody               string
 	commentID                 string
+	commentAction             string
+	commentPreviousBody       string
 	actor                     string
 	fromStatusOption          string
 	moveClosed                string
+	config                    string
+	excludeLabels             string
+	requireLabels             string
+	excludeAuthors            string
+	skipDrafts                string
+	baseBranches              string
+	excludeBaseBranches       string
+	headBranches              string
+	excludeHeadBranches       string
+	reviewStatusMap           string
+	workingDays               string
+	workingHours              string
+	timeZone                  string
+	holidays                  string
+	transitions               []string
+	itemAction                string
+	stalenessBasis            string
+	filter                    string
+	concurrency               string
+	batchSize                 string
+	cacheFile                 string
+	cacheTTL                  string
+	createMissingLabels       string
+	strict                    string
+	githubURL                 string
+	record                    string
+	repos                     string
+	repoSearch                string
+	replay                    string
 }
 
 func CreateRootCommand() *cobra.Command {
@@ -44,11 +81,44 @@ func CreateRootCommand() *cobra.Command {
 	flag.StringVar(&rootArgs.token, "token", "", "--token github token")
 	flag.StringVar(&rootArgs.owner, "owner", "", "--owner github organization / owner")
 	flag.StringVar(&rootArgs.repo, "repo", "", "--repo github repository")
+	flag.StringVar(
+		&rootArgs.githubURL,
+		"github-url",
+		"",
+		"--github-url=https://github.example.com/api/graphql the GraphQL endpoint of GitHub Enterprise Server, "+
+			"defaults to github.com",
+	)
+	flag.StringVar(
+		&rootArgs.repos,
+		"repos",
+		"",
+		"--repos=caretaker,api-* comma separated list of repositories or glob patterns scan processes instead of --repo",
+	)
+	flag.StringVar(
+		&rootArgs.repoSearch,
+		"repo-search",
+		"",
+		"--repo-search=topic:caretaker scan processes the repositories of the owner matching the search as well",
+	)
+	flag.StringVar(
+		&rootArgs.record,
+		"record",
+		"",
+		"--record=recordings/ write every GraphQL request and response into the directory, the token is redacted",
+	)
+	flag.StringVar(
+		&rootArgs.replay,
+		"replay",
+		"",
+		"--replay=recordings/ answer the GraphQL requests with the responses recorded with --record",
+	)
 	flag.StringVar(
 		&rootArgs.scanInterval,
 		"scan-interval",
 		"24h",
-		"--scan-interval defines after how long duration a pull request should be considered scan",
+		"--scan-interval defines after how long duration a pull request should be considered scan, "+
+			"supports days (3d) and business days (2bd); with a working calendar, days and business days are measured "+
+			"in working time, while Go durations like 24h are always wall-clock time",
 	)
 	flag.StringVar(
 		&rootArgs.authorName,
@@ -81,7 +151,27 @@ func CreateRootCommand() *cobra.Command {
 		&rootArgs.projectNumber,
 		"project-number",
 		"0",
-		"--issue-number the number of the project to add a created issue to")
+		"--project-number the number of the project, assign-issue accepts a comma separated list of numbers")
+	flag.StringVar(
+		&rootArgs.fieldValues,
+		"field-values",
+		"",
+		"--field-values=Priority=High,Estimate=3 initial field values of the items assign-issue creates")
+	flag.StringVar(
+		&rootArgs.projectOwner,
+		"project-owner",
+		"",
+		"--project-owner=planning the organization or user owning the project, defaults to --owner")
+	flag.StringVar(
+		&rootArgs.projectOwnerIsOrg,
+		"project-owner-is-organization",
+		"",
+		"--project-owner-is-organization=true|false overrides the type of the project owner, which is looked up by default")
+	flag.StringVar(
+		&rootArgs.projectID,
+		"project-id",
+		"",
+		"--project-id=PVT_ the node ID of the project, used instead of --project-owner if --project-number is 0")
 	flag.StringVar(
 		&rootArgs.statusOption,
 		"status-option",
@@ -102,7 +192,7 @@ func CreateRootCommand() *cobra.Command {
 		&rootArgs.isOrganization,
 		"is-organization",
 		"",
-		"--is-organization=true is defined if the user is an organization",
+		"--is-organization=true|false overrides the type of the owner, which is looked up by default",
 	)
 	flag.StringVar(
 		&rootArgs.disableComments,
@@ -122,6 +212,18 @@ func CreateRootCommand() *cobra.Command {
 		"",
 		"--comment-id:IC_ the node_id of the comment that triggered this action",
 	)
+	flag.StringVar(
+		&rootArgs.commentAction,
+		"comment-action",
+		"",
+		"--comment-action:edited the action of the issue_comment event; created, edited or deleted",
+	)
+	flag.StringVar(
+		&rootArgs.commentPreviousBody,
+		"comment-previous-body",
+		"",
+		"--comment-previous-body the body of the comment before it was edited",
+	)
 	flag.StringVar(
 		&rootArgs.actor,
 		"actor",
@@ -135,6 +237,167 @@ func CreateRootCommand() *cobra.Command {
 		"--move-closed will edit closed issues, by default closed issues are not moved",
 	)
 
+	flag.StringVar(
+		&rootArgs.config,
+		"config",
+		"",
+		"--config path to a configuration file which defines, for example, custom slash commands",
+	)
+
+	flag.StringVar(
+		&rootArgs.excludeLabels,
+		"exclude-labels",
+		"",
+		"--exclude-labels=wip,do-not-merge comma separated list of labels; pull requests with any of them are skipped",
+	)
+	flag.StringVar(
+		&rootArgs.requireLabels,
+		"require-labels",
+		"",
+		"--require-labels=ready comma separated list of labels; pull requests without all of them are skipped",
+	)
+	flag.StringVar(
+		&rootArgs.excludeAuthors,
+		"exclude-authors",
+		"",
+		"--exclude-authors=dependabot,renovate* comma separated list of author login patterns to skip",
+	)
+	flag.StringVar(
+		&rootArgs.skipDrafts,
+		"skip-drafts",
+		"",
+		"--skip-drafts=true skips draft pull requests",
+	)
+	flag.StringVar(
+		&rootArgs.baseBranches,
+		"base-branches",
+		"",
+		"--base-branches=main comma separated list of base branch patterns; other base branches are skipped",
+	)
+	flag.StringVar(
+		&rootArgs.excludeBaseBranches,
+		"exclude-base-branches",
+		"",
+		"--exclude-base-branches=release/* comma separated list of base branch patterns to skip",
+	)
+	flag.StringVar(
+		&rootArgs.headBranches,
+		"head-branches",
+		"",
+		"--head-branches=feature/* comma separated list of head branch patterns; other head branches are skipped",
+	)
+	flag.StringVar(
+		&rootArgs.excludeHeadBranches,
+		"exclude-head-branches",
+		"",
+		"--exclude-head-branches=dependabot/* comma separated list of head branch patterns to skip",
+	)
+
+	flag.StringVar(
+		&rootArgs.reviewStatusMap,
+		"review-status-map",
+		"",
+		"--review-status-map=review_requested=In Review,changes_requested=In Progress maps review states to statuses",
+	)
+
+	flag.StringVar(
+		&rootArgs.workingDays,
+		"working-days",
+		"",
+		"--working-days=Mon-Fri days which count towards intervals in days, setting any calendar flag enables the calendar",
+	)
+	flag.StringVar(
+		&rootArgs.workingHours,
+		"working-hours",
+		"",
+		"--working-hours=09:00-17:00 hours of a working day which count towards intervals in days",
+	)
+	flag.StringVar(
+		&rootArgs.timeZone,
+		"time-zone",
+		"",
+		"--time-zone=Europe/Berlin time zone of the working hours, defaults to UTC",
+	)
+	flag.StringVar(
+		&rootArgs.holidays,
+		"holidays",
+		"",
+		"--holidays=2024-12-25,2024-12-26 comma separated list of dates which don't count towards intervals in days",
+	)
+
+	flag.StringArrayVar(
+		&rootArgs.transitions,
+		"transition",
+		nil,
+		"--transition=from=Done,to=Archived,after=14d a scan-project transition, can be repeated; "+
+			"multiple transitions in one value are separated by new lines or semicolons",
+	)
+
+	flag.StringVar(
+		&rootArgs.itemAction,
+		"item-action",
+		"",
+		"--item-action=archive what scan-project does with the matching items: "+
+			"move, archive, delete, unarchive or convert (draft issues into issues)",
+	)
+
+	flag.StringVar(
+		&rootArgs.stalenessBasis,
+		"staleness-basis",
+		"",
+		"--staleness-basis=status what scan-project measures the intervals from: "+
+			"status (default, when the status was set), item (any change to the item) or content (the issue or pull request)",
+	)
+
+	flag.StringVar(
+		&rootArgs.filter,
+		"filter",
+		"",
+		`--filter='status:"In Review" label:bug -assignee:@me' only scan the project items matching the filter`,
+	)
+
+	flag.StringVar(
+		&rootArgs.concurrency,
+		"concurrency",
+		"1",
+		"--concurrency=4 the number of pull requests, project items or issues updated at the same time",
+	)
+
+	flag.StringVar(
+		&rootArgs.batchSize,
+		"batch-size",
+		"1",
+		"--batch-size=10 the number of concurrent status updates, labels and comments sent in a single request",
+	)
+
+	flag.StringVar(
+		&rootArgs.cacheFile,
+		"cache-file",
+		"",
+		"--cache-file=.caretaker-cache.json persist label, user and team IDs and project fields between runs",
+	)
+
+	flag.StringVar(
+		&rootArgs.cacheTTL,
+		"cache-ttl",
+		"24h",
+		"--cache-ttl=7d how long the entries of --cache-file are kept",
+	)
+
+	flag.StringVar(
+		&rootArgs.createMissingLabels,
+		"create-missing-labels",
+		"",
+		"--create-missing-labels=true create labels which are added but don't exist in the repository",
+	)
+
+	flag.StringVar(
+		&rootArgs.strict,
+		"strict",
+		"",
+		"--strict=true fail if a project of an issue doesn't have the requested status option instead of skipping it",
+	)
+
 	markFlagAsRequired(rootCmd, "token")
 	markFlagAsRequired(rootCmd, "owner")
 
@@ -156,6 +419,65 @@ func CreateRootCommand() *cobra.Command {
 	return rootCmd
 }
 
+// splitList splits a comma separated flag value and drops empty entries.
+func splitList(value string) []string {
+	var result []string
+
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
+
+// splitMap splits a comma separated list of key=value pairs.
+func splitMap(value string) (map[string]string, error) {
+	result := make(map[string]string)
+
+	for _, pair := range splitList(value) {
+		k, v, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid format for pair, wanted k=v but was: %s", pair)
+		}
+
+		result[strings.TrimSpace(k)] = strings.TrimSpace(v)
+	}
+
+	return result, nil
+}
+
+// parseCount parses a flag which is a positive number, like --concurrency. An empty value is one.
+func parseCount(flag, value string) (int, error) {
+	if value == "" {
+		return 1, nil
+	}
+
+	count, err := strconv.Atoi(value)
+	if err != nil || count < 1 {
+		return 0, fmt.Errorf("invalid %s %s, wanted a positive number", flag, value)
+	}
+
+	return count, nil
+}
+
+// parseOptionalBool parses a flag which overrides a value looked up otherwise, like --is-organization. An empty
+// value is nil. Values which strconv.ParseBool reads as false are false; like before the lookup existed, any other
+// value is true, so workflows passing yes keep working.
+func parseOptionalBool(value string) *bool {
+	if value == "" {
+		return nil
+	}
+
+	result, err := strconv.ParseBool(value)
+	if err != nil {
+		result = true
+	}
+
+	return &result
+}
+
 func markFlagAsRequired(cmd *cobra.Command, flag string) {
 	if err := cmd.MarkPersistentFlagRequired(flag); err != nil {
 		fmt.Printf("failed to mark %s flag as required", flag)
@@ -3,13 +3,13 @@ package cmd
 import (
 	"context"
 	"fmt"
-	"time"
+	"os"
 
-	"github.com/shurcooL/githubv4"
 	"github.com/spf13/cobra"
-	"golang.org/x/oauth2"
 
+	"github.com/skarlso/caretaker/pkg/calendar"
 	"github.com/skarlso/caretaker/pkg/client"
+	"github.com/skarlso/caretaker/pkg/config"
 	"github.com/skarlso/caretaker/pkg/logger"
 	"github.com/skarlso/caretaker/pkg/scan"
 )
@@ -28,36 +28,133 @@ func CreateScanCommand(rootArgs *rootArgsStruct) *cobra.Command {
 func scanRunE(rootArgs *rootArgsStruct) func(cmd *cobra.Command, args []string) error {
 	return func(_ *cobra.Command, _ []string) error {
 		ctx := context.Background()
-		ts := oauth2.StaticTokenSource(
-			&oauth2.Token{AccessToken: rootArgs.token},
-		)
-		tc := oauth2.NewClient(ctx, ts)
-		gclient := githubv4.NewClient(tc)
-
 		// setup logger
 		var log logger.Logger = &logger.QuiteLogger{}
 		if rootArgs.verbose {
 			log = &logger.VerboseLogger{}
 		}
 
+		gclient, err := newGraphQLClient(ctx, log, rootArgs)
+		if err != nil {
+			return err
+		}
+
 		log.Log("running scan command")
 
-		interval, err := time.ParseDuration(rootArgs.scanInterval)
+		cfg, err := config.Load(rootArgs.config)
+		if err != nil {
+			return err
+		}
+
+		workingCalendar, err := newCalendar(rootArgs, cfg, rootArgs.scanInterval)
+		if err != nil {
+			return fmt.Errorf("failed to create working calendar: %w", err)
+		}
+
+		interval, err := calendar.ParseDuration(rootArgs.scanInterval, workingCalendar)
 		if err != nil {
 			return fmt.Errorf("failed to parse interval: %w", err)
 		}
 
-		client := client.NewCaretaker(log, gclient, client.Options{
-			Repo:  rootArgs.repo,
-			Owner: rootArgs.owner,
-		})
-		scanner := scan.NewScanner(log, client, scan.Options{
+		// Only days and business days are measured in working time.
+		if !calendar.IsWorkingDuration(rootArgs.scanInterval) {
+			workingCalendar = nil
+		}
+
+		reviewStatusMap, err := splitMap(rootArgs.reviewStatusMap)
+		if err != nil {
+			return fmt.Errorf("failed to parse review status map: %w", err)
+		}
+
+		reviewStatuses, err := scan.ParseReviewStatuses(reviewStatusMap)
+		if err != nil {
+			return fmt.Errorf("failed to parse review status map: %w", err)
+		}
+
+		concurrency, err := parseCount("concurrency", rootArgs.concurrency)
+		if err != nil {
+			return err
+		}
+
+		isOrganization := parseOptionalBool(rootArgs.isOrganization)
+
+		queryCache, err := newCache(log, rootArgs)
+		if err != nil {
+			return err
+		}
+		defer saveCache(log, queryCache)
+
+		newClient := func(repository string) client.Client {
+			return client.NewCaretaker(log, gclient, client.Options{
+				Repo:                repository,
+				Owner:               rootArgs.owner,
+				IsOrganization:      isOrganization,
+				MoveClosed:          rootArgs.moveClosed != "",
+				Cache:               queryCache,
+				CreateMissingLabels: rootArgs.createMissingLabels != "",
+				Strict:              rootArgs.strict != "",
+			})
+		}
+
+		repositories := []string{rootArgs.repo}
+		if rootArgs.repos != "" || rootArgs.repoSearch != "" {
+			repositories, err = scan.ResolveRepositories(
+				ctx, log, newClient(""), splitList(rootArgs.repos), rootArgs.repoSearch)
+			if err != nil {
+				return fmt.Errorf("failed to find repositories: %w", err)
+			}
+		}
+
+		scanner := scan.NewMultiScanner(log, newClient, scan.Options{
+			Filters: scan.Filters{
+				ExcludeLabels:       splitList(rootArgs.excludeLabels),
+				RequireLabels:       splitList(rootArgs.requireLabels),
+				ExcludeAuthors:      splitList(rootArgs.excludeAuthors),
+				SkipDrafts:          rootArgs.skipDrafts != "",
+				BaseBranches:        splitList(rootArgs.baseBranches),
+				ExcludeBaseBranches: splitList(rootArgs.excludeBaseBranches),
+				HeadBranches:        splitList(rootArgs.headBranches),
+				ExcludeHeadBranches: splitList(rootArgs.excludeHeadBranches),
+			},
 			Interval:        interval,
+			Calendar:        workingCalendar,
 			ScanLabel:       rootArgs.pullRequestProcessedLabel,
 			DisableComments: rootArgs.disableComments != "",
 			StatusName:      rootArgs.statusOption,
+			ReviewStatuses:  reviewStatuses,
+			Concurrency:     concurrency,
 		})
 
-		return scanner.Scan(ctx)
+		results, err := scanner.Scan(ctx, repositories)
+
+		summary := scan.Summary(results)
+		log.Log("scanned %d repositories:\n%s", len(results), summary)
+
+		if summaryErr := writeStepSummary(summary); summaryErr != nil {
+			log.Log("failed to write step summary: %s", summaryErr)
+		}
+
+		return err
+	}
+}
+
+// writeStepSummary appends the Markdown to the summary of the workflow step if it runs in GitHub Actions.
+func writeStepSummary(markdown string) error {
+	path := os.Getenv("GITHUB_STEP_SUMMARY")
+	if path == "" {
+		return nil
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open step summary: %w", err)
 	}
+
+	if _, err := f.WriteString(markdown); err != nil {
+		_ = f.Close()
+
+		return fmt.Errorf("failed to write step summary: %w", err)
+	}
+
+	return f.Close()
 }
@@ -4,13 +4,12 @@ import (
 	"context"
 	"fmt"
 	"strconv"
-	"time"
 
-	"github.com/shurcooL/githubv4"
 	"github.com/spf13/cobra"
-	"golang.org/x/oauth2"
 
 	"github.com/skarlso/caretaker/pkg/client"
+	"github.com/skarlso/caretaker/pkg/config"
+	"github.com/skarlso/caretaker/pkg/filter"
 	"github.com/skarlso/caretaker/pkg/logger"
 	"github.com/skarlso/caretaker/pkg/scanproject"
 )
@@ -29,18 +28,17 @@ func CreateScanProjectCommand(rootArgs *rootArgsStruct) *cobra.Command {
 func scanProjectRunE(rootArgs *rootArgsStruct) func(cmd *cobra.Command, args []string) error {
 	return func(_ *cobra.Command, _ []string) error {
 		ctx := context.Background()
-		ts := oauth2.StaticTokenSource(
-			&oauth2.Token{AccessToken: rootArgs.token},
-		)
-		tc := oauth2.NewClient(ctx, ts)
-		gclient := githubv4.NewClient(tc)
-
 		// setup logger
 		var log logger.Logger = &logger.QuiteLogger{}
 		if rootArgs.verbose {
 			log = &logger.VerboseLogger{}
 		}
 
+		gclient, err := newGraphQLClient(ctx, log, rootArgs)
+		if err != nil {
+			return err
+		}
+
 		log.Log("running scan issues command")
 
 		projectNumber, err := strconv.Atoi(rootArgs.projectNumber)
@@ -48,22 +46,68 @@ func scanProjectRunE(rootArgs *rootArgsStruct) func(cmd *cobra.Command, args []s
 			return fmt.Errorf("failed to convert project number: %w", err)
 		}
 
-		interval, err := time.ParseDuration(rootArgs.scanInterval)
+		cfg, err := config.Load(rootArgs.config)
+		if err != nil {
+			return err
+		}
+
+		definitions, err := collectTransitions(rootArgs, cfg)
+		if err != nil {
+			return err
+		}
+
+		workingCalendar, err := newCalendar(rootArgs, cfg, intervals(definitions)...)
+		if err != nil {
+			return fmt.Errorf("failed to create working calendar: %w", err)
+		}
+
+		transitions, err := parseTransitions(definitions, workingCalendar)
+		if err != nil {
+			return err
+		}
+
+		stalenessBasis, err := scanproject.ParseStalenessBasis(rootArgs.stalenessBasis)
+		if err != nil {
+			return err
+		}
+
+		itemFilter, err := filter.Parse(rootArgs.filter)
+		if err != nil {
+			return fmt.Errorf("failed to parse filter: %w", err)
+		}
+
+		concurrency, err := parseCount("concurrency", rootArgs.concurrency)
+		if err != nil {
+			return err
+		}
+
+		isOrganization := parseOptionalBool(rootArgs.isOrganization)
+		projectOwnerIsOrganization := parseOptionalBool(rootArgs.projectOwnerIsOrg)
+
+		queryCache, err := newCache(log, rootArgs)
 		if err != nil {
-			return fmt.Errorf("failed to parse interval: %w", err)
+			return err
 		}
+		defer saveCache(log, queryCache)
 
 		caretaker := client.NewCaretaker(log, gclient, client.Options{
-			Repo:           rootArgs.repo,
-			Owner:          rootArgs.owner,
-			IsOrganization: rootArgs.isOrganization != "",
-			MoveClosed:     rootArgs.moveClosed != "",
+			Repo:                       rootArgs.repo,
+			Owner:                      rootArgs.owner,
+			IsOrganization:             isOrganization,
+			ProjectOwner:               rootArgs.projectOwner,
+			ProjectOwnerIsOrganization: projectOwnerIsOrganization,
+			ProjectID:                  rootArgs.projectID,
+			MoveClosed:                 rootArgs.moveClosed != "",
+			Cache:                      queryCache,
+			Strict:                     rootArgs.strict != "",
 		})
 		scanner := scanproject.NewScanner(log, caretaker, scanproject.Options{
-			ProjectNumber: projectNumber,
-			FromStatus:    rootArgs.fromStatusOption,
-			ToStatus:      rootArgs.statusOption,
-			Interval:      interval,
+			ProjectNumber:  projectNumber,
+			Transitions:    transitions,
+			Calendar:       workingCalendar,
+			StalenessBasis: stalenessBasis,
+			Filter:         itemFilter,
+			Concurrency:    concurrency,
 		})
 
 		return scanner.ScanIssues(ctx)
@@ -5,15 +5,19 @@ import (
 	"fmt"
 	"strconv"
 
-	"github.com/shurcooL/githubv4"
 	"github.com/spf13/cobra"
-	"golang.org/x/oauth2"
 
 	"github.com/skarlso/caretaker/pkg/client"
+	"github.com/skarlso/caretaker/pkg/config"
 	"github.com/skarlso/caretaker/pkg/logger"
 	"github.com/skarlso/caretaker/pkg/slash"
 	"github.com/skarlso/caretaker/pkg/slash/assign"
+	"github.com/skarlso/caretaker/pkg/slash/label"
+	"github.com/skarlso/caretaker/pkg/slash/link"
+	"github.com/skarlso/caretaker/pkg/slash/macro"
+	"github.com/skarlso/caretaker/pkg/slash/review"
 	"github.com/skarlso/caretaker/pkg/slash/status"
+	"github.com/skarlso/caretaker/pkg/slash/undo"
 )
 
 // CreateSlashCommand defines a command that handles comments made by users on objects that Caretaker tracks.
@@ -33,36 +37,77 @@ func CreateSlashCommand(rootArgs *rootArgsStruct) *cobra.Command {
 func slashRunE(rootArgs *rootArgsStruct) func(cmd *cobra.Command, args []string) error {
 	return func(_ *cobra.Command, _ []string) error {
 		ctx := context.Background()
-		ts := oauth2.StaticTokenSource(
-			&oauth2.Token{AccessToken: rootArgs.token},
-		)
-		tc := oauth2.NewClient(ctx, ts)
-		gclient := githubv4.NewClient(tc)
-
 		// setup logger
 		var log logger.Logger = &logger.QuiteLogger{}
 		if rootArgs.verbose {
 			log = &logger.VerboseLogger{}
 		}
 
+		gclient, err := newGraphQLClient(ctx, log, rootArgs)
+		if err != nil {
+			return err
+		}
+
+		isOrganization := parseOptionalBool(rootArgs.isOrganization)
+
+		queryCache, err := newCache(log, rootArgs)
+		if err != nil {
+			return err
+		}
+		defer saveCache(log, queryCache)
+
 		client := client.NewCaretaker(log, gclient, client.Options{
-			Repo:           rootArgs.repo,
-			Owner:          rootArgs.owner,
-			IsOrganization: rootArgs.isOrganization != "",
+			Repo:                rootArgs.repo,
+			Owner:               rootArgs.owner,
+			IsOrganization:      isOrganization,
+			MoveClosed:          rootArgs.moveClosed != "",
+			Cache:               queryCache,
+			CreateMissingLabels: rootArgs.createMissingLabels != "",
+			Strict:              rootArgs.strict != "",
 		})
 
 		assignHandler := assign.NewHandler(client)
 		statusHandler := status.NewHandler(client)
-		s := slash.NewSlashHandler(client)
+		repository := rootArgs.owner + "/" + rootArgs.repo
+		linkHandler := link.NewHandler(client, repository)
+		unlinkHandler := link.NewUnlinkHandler(client, repository)
+		labelHandler := label.NewHandler(client)
+		reviewHandler := review.NewHandler(client)
+		undoHandler := undo.NewHandler(client)
+		s := slash.NewSlashHandler(log, client)
 		s.RegisterHandler(assign.Command, assignHandler)
 		s.RegisterHandler(status.Command, statusHandler)
+		s.RegisterHandler(link.Command, linkHandler)
+		s.RegisterHandler(link.UnlinkCommand, unlinkHandler)
+		s.RegisterHandler(label.Command, labelHandler)
+		s.RegisterHandler(review.Command, reviewHandler)
+		s.RegisterHandler(undo.Command, undoHandler)
 		s.RegisterHandler(slash.Help, s)
 
+		cfg, err := config.Load(rootArgs.config)
+		if err != nil {
+			return err
+		}
+
+		for _, command := range cfg.Commands {
+			handler := macro.NewHandler(command, s)
+			if s.HasHandler(handler.Name()) {
+				return fmt.Errorf("custom command %s conflicts with an existing command", handler.Name())
+			}
+
+			s.RegisterHandler(handler.Name(), handler)
+		}
+
 		prNumber, err := strconv.Atoi(rootArgs.pullRequestNumber)
 		if err != nil {
 			return fmt.Errorf("failed to convert pull number: %w", err)
 		}
 
-		return s.Run(ctx, prNumber, rootArgs.actor, rootArgs.commentID, rootArgs.commentBody)
+		return s.Run(ctx, prNumber, rootArgs.actor, slash.Comment{
+			ID:           rootArgs.commentID,
+			Action:       rootArgs.commentAction,
+			Body:         rootArgs.commentBody,
+			PreviousBody: rootArgs.commentPreviousBody,
+		})
 	}
 }
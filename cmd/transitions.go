@@ -0,0 +1,122 @@
+package cmd
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/skarlso/caretaker/pkg/calendar"
+	"github.com/skarlso/caretaker/pkg/config"
+	"github.com/skarlso/caretaker/pkg/scanproject"
+)
+
+// transitionSeparator splits multiple transitions given in a single flag value, for example, from an action input.
+var transitionSeparator = regexp.MustCompile(`[\n;]`)
+
+// collectTransitions gathers the transitions from the configuration file, the --transition flags and
+// the legacy --from-status-option / --status-option / --scan-interval flags, in that order.
+func collectTransitions(rootArgs *rootArgsStruct, cfg *config.Config) ([]config.Transition, error) {
+	transitions := append([]config.Transition{}, cfg.Transitions...)
+
+	for _, value := range rootArgs.transitions {
+		for _, definition := range transitionSeparator.Split(value, -1) {
+			if strings.TrimSpace(definition) == "" {
+				continue
+			}
+
+			pairs, err := splitMap(definition)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse transition %s: %w", definition, err)
+			}
+
+			transitions = append(transitions, config.Transition{
+				From:       pairs["from"],
+				To:         pairs["to"],
+				After:      pairs["after"],
+				Action:     pairs["action"],
+				Repository: pairs["repository"],
+			})
+		}
+	}
+
+	if rootArgs.statusOption != "" || rootArgs.itemAction != "" {
+		transitions = append(transitions, config.Transition{
+			From:   rootArgs.fromStatusOption,
+			To:     rootArgs.statusOption,
+			After:  rootArgs.scanInterval,
+			Action: rootArgs.itemAction,
+		})
+	}
+
+	// archived items are only handled by unarchive, so a status can have one transition for each
+	type key struct {
+		from     string
+		archived bool
+	}
+
+	seen := make(map[key]struct{}, len(transitions))
+
+	for _, t := range transitions {
+		action, err := scanproject.ParseAction(t.Action)
+		if err != nil {
+			return nil, fmt.Errorf("invalid transition from %q: %w", t.From, err)
+		}
+
+		if t.After == "" || (action.RequiresStatus() && t.To == "") {
+			return nil, fmt.Errorf("transition from %q requires a to status and an after duration", t.From)
+		}
+
+		k := key{from: t.From, archived: action == scanproject.ActionUnarchive}
+		if _, ok := seen[k]; ok {
+			return nil, fmt.Errorf("multiple transitions defined from status %q", t.From)
+		}
+
+		seen[k] = struct{}{}
+	}
+
+	if len(transitions) == 0 {
+		return nil, fmt.Errorf("no transitions defined, set --status-option, --item-action or --transition")
+	}
+
+	return transitions, nil
+}
+
+// parseTransitions converts the collected transitions, parsing their durations with the working calendar.
+func parseTransitions(
+	transitions []config.Transition,
+	workingCalendar *calendar.Calendar,
+) ([]scanproject.Transition, error) {
+	result := make([]scanproject.Transition, 0, len(transitions))
+
+	for _, t := range transitions {
+		interval, err := calendar.ParseDuration(t.After, workingCalendar)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse interval of transition from %q: %w", t.From, err)
+		}
+
+		action, err := scanproject.ParseAction(t.Action)
+		if err != nil {
+			return nil, fmt.Errorf("invalid transition from %q: %w", t.From, err)
+		}
+
+		result = append(result, scanproject.Transition{
+			From:        t.From,
+			To:          t.To,
+			Interval:    interval,
+			WorkingTime: calendar.IsWorkingDuration(t.After),
+			Action:      action,
+			Repository:  t.Repository,
+		})
+	}
+
+	return result, nil
+}
+
+func intervals(transitions []config.Transition) []string {
+	result := make([]string, 0, len(transitions))
+	for _, t := range transitions {
+		result = append(result, t.After)
+	}
+
+	return result
+}
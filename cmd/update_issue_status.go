@@ -5,9 +5,7 @@ import (
 	"fmt"
 	"strconv"
 
-	"github.com/shurcooL/githubv4"
 	"github.com/spf13/cobra"
-	"golang.org/x/oauth2"
 
 	"github.com/skarlso/caretaker/pkg/client"
 	"github.com/skarlso/caretaker/pkg/logger"
@@ -28,18 +26,17 @@ func CreateUpdateIssueCommand(rootArgs *rootArgsStruct) *cobra.Command {
 func updateIssueRunE(rootArgs *rootArgsStruct) func(cmd *cobra.Command, args []string) error {
 	return func(_ *cobra.Command, _ []string) error {
 		ctx := context.Background()
-		ts := oauth2.StaticTokenSource(
-			&oauth2.Token{AccessToken: rootArgs.token},
-		)
-		tc := oauth2.NewClient(ctx, ts)
-		gclient := githubv4.NewClient(tc)
-
 		// setup logger
 		var log logger.Logger = &logger.QuiteLogger{}
 		if rootArgs.verbose {
 			log = &logger.VerboseLogger{}
 		}
 
+		gclient, err := newGraphQLClient(ctx, log, rootArgs)
+		if err != nil {
+			return err
+		}
+
 		log.Log("running update issue status command")
 
 		projectNumber, err := strconv.Atoi(rootArgs.projectNumber)
@@ -52,10 +49,25 @@ func updateIssueRunE(rootArgs *rootArgsStruct) func(cmd *cobra.Command, args []s
 			return fmt.Errorf("failed to convert issue number: %w", err)
 		}
 
+		isOrganization := parseOptionalBool(rootArgs.isOrganization)
+		projectOwnerIsOrganization := parseOptionalBool(rootArgs.projectOwnerIsOrg)
+
+		queryCache, err := newCache(log, rootArgs)
+		if err != nil {
+			return err
+		}
+		defer saveCache(log, queryCache)
+
 		caretaker := client.NewCaretaker(log, gclient, client.Options{
-			Repo:           rootArgs.repo,
-			Owner:          rootArgs.owner,
-			IsOrganization: rootArgs.isOrganization != "",
+			Repo:                       rootArgs.repo,
+			Owner:                      rootArgs.owner,
+			IsOrganization:             isOrganization,
+			ProjectOwner:               rootArgs.projectOwner,
+			ProjectOwnerIsOrganization: projectOwnerIsOrganization,
+			ProjectID:                  rootArgs.projectID,
+			MoveClosed:                 rootArgs.moveClosed != "",
+			Cache:                      queryCache,
+			Strict:                     rootArgs.strict != "",
 		})
 		updater := updateissue.NewUpdateIssueAction(log, caretaker, updateissue.Options{
 			ProjectNumber: projectNumber,
@@ -2,6 +2,7 @@ package main
 
 import (
 	"log"
+	"os"
 
 	"github.com/skarlso/caretaker/cmd"
 )
@@ -9,6 +10,7 @@ import (
 func main() {
 	root := cmd.CreateRootCommand()
 	if err := root.Execute(); err != nil {
-		log.Fatal(err)
+		log.Print(err)
+		os.Exit(cmd.ExitCode(err))
 	}
 }
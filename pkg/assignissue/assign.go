@@ -2,15 +2,57 @@ package assignissue
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/shurcooL/githubv4"
 
 	"github.com/skarlso/caretaker/pkg/client"
 	"github.com/skarlso/caretaker/pkg/logger"
 )
 
-type Options struct {
+// Assignment adds the issue to a project if it matches the conditions.
+type Assignment struct {
+	// ProjectNumber addresses the project under the project owner of the client. Zero addresses the
+	// project with the ProjectID of the client.
 	ProjectNumber int
-	IssueNumber   int
+	// Labels restricts the assignment to issues with at least one of the labels. Empty matches all issues.
+	Labels []string
+	// Title restricts the assignment to issues whose title matches. Nil matches all issues.
+	Title *regexp.Regexp
+	// Status is the initial Status of the new item. Empty leaves the Status unset.
+	Status string
+	// Fields are the initial values of other fields of the new item by the names of the fields.
+	Fields map[string]string
+}
+
+// Matches returns whether the issue fulfills the conditions of the assignment.
+func (a Assignment) Matches(issue client.Issue) bool {
+	if a.Title != nil && !a.Title.MatchString(string(issue.Title)) {
+		return false
+	}
+
+	if len(a.Labels) == 0 {
+		return true
+	}
+
+	for _, label := range issue.Labels.Nodes {
+		for _, l := range a.Labels {
+			if strings.EqualFold(string(label.Name), l) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+type Options struct {
+	IssueNumber int
+	Assignments []Assignment
 }
 
 type Assigner struct {
@@ -28,10 +70,125 @@ func NewAssignIssueAction(log logger.Logger, client client.Client, opts Options)
 	}
 }
 
-// Assign assigns an issue to project.
+// Assign adds the issue to the projects of the matching assignments and sets the initial field values of the
+// new items. Items which exist already only get the initial Status if they have none, for example, because
+// setting it failed in an earlier run; their other fields are left alone. A failing assignment doesn't stop the
+// others.
 func (c *Assigner) Assign(ctx context.Context) error {
-	if err := c.client.AssignIssueToProject(ctx, c.IssueNumber, c.ProjectNumber); err != nil {
-		return fmt.Errorf("failed to assign issue to project: %w", err)
+	issue, err := c.client.Issue(ctx, c.IssueNumber)
+	if err != nil {
+		return fmt.Errorf("failed to fetch issue: %w", err)
+	}
+
+	var errs []error
+
+	for _, assignment := range c.Assignments {
+		if !assignment.Matches(issue) {
+			c.log.Log("issue number %d doesn't match the conditions of project %d, skipping",
+				c.IssueNumber, assignment.ProjectNumber)
+
+			continue
+		}
+
+		if err := c.assign(ctx, &issue, assignment); err != nil {
+			errs = append(errs, fmt.Errorf("failed to assign issue to project %d: %w", assignment.ProjectNumber, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// assign adds the issue to the project of the assignment. The new item and its Status are added to the items of
+// the issue, so later assignments to the same project don't add it again or overwrite the Status.
+func (c *Assigner) assign(ctx context.Context, issue *client.Issue, assignment Assignment) error {
+	project, err := c.client.Project(ctx, assignment.ProjectNumber)
+	if err != nil {
+		return err
+	}
+
+	for i := range issue.ProjectItems.Nodes {
+		if issue.ProjectItems.Nodes[i].Project.ID == project.ID {
+			return c.repair(ctx, issue, &issue.ProjectItems.Nodes[i], assignment)
+		}
+	}
+
+	c.log.Log(
+		"assigning issue number %d with title %s to project number %d and title %s",
+		issue.Number,
+		issue.Title,
+		project.Number,
+		project.Title,
+	)
+
+	item, err := c.client.AddProjectItem(ctx, project.ID, issue.ID)
+	if err != nil {
+		return err
+	}
+
+	item.Project = project
+	issue.ProjectItems.Nodes = append(issue.ProjectItems.Nodes, item)
+	added := &issue.ProjectItems.Nodes[len(issue.ProjectItems.Nodes)-1]
+
+	if err := c.setStatus(ctx, added, assignment.Status); err != nil {
+		return err
+	}
+
+	return c.setFields(ctx, project, item, assignment)
+}
+
+// repair sets the initial Status of an existing item which has none. Items with a Status are left alone, it may
+// have been changed since.
+func (c *Assigner) repair(
+	ctx context.Context,
+	issue *client.Issue,
+	item *client.ProjectV2Item,
+	assignment Assignment,
+) error {
+	if assignment.Status == "" || item.FieldValueByName.ProjectV2SingleSelectField.Name != "" {
+		c.log.Log("issue number %d is already in project %s, skipping", issue.Number, item.Project.Title)
+
+		return nil
+	}
+
+	c.log.Log("issue number %d is already in project %s without a Status, setting it to %s",
+		issue.Number, item.Project.Title, assignment.Status)
+
+	return c.setStatus(ctx, item, assignment.Status)
+}
+
+// setStatus sets the Status of the item and records it on the item. An empty Status is left unset.
+func (c *Assigner) setStatus(ctx context.Context, item *client.ProjectV2Item, status string) error {
+	if status == "" {
+		return nil
+	}
+
+	if err := c.client.SetProjectItemField(ctx, item.Project.ID, item.ID, "Status", status); err != nil {
+		return err
+	}
+
+	item.FieldValueByName.ProjectV2SingleSelectField.Name = githubv4.String(status)
+
+	return nil
+}
+
+// setFields sets the initial values of the other fields of a new item in the order of their names.
+func (c *Assigner) setFields(
+	ctx context.Context,
+	project client.ProjectV2,
+	item client.ProjectV2Item,
+	assignment Assignment,
+) error {
+	names := make([]string, 0, len(assignment.Fields))
+	for name := range assignment.Fields {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		if err := c.client.SetProjectItemField(ctx, project.ID, item.ID, name, assignment.Fields[name]); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -0,0 +1,144 @@
+package assignissue
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skarlso/caretaker/pkg/client"
+	"github.com/skarlso/caretaker/pkg/client/fakes"
+	"github.com/skarlso/caretaker/pkg/logger"
+)
+
+func TestAssigner_Assign(t *testing.T) {
+	projects := map[int]client.ProjectV2{
+		1: {ID: "PVT_1", Number: 1, Title: "board"},
+		2: {ID: "PVT_2", Number: 2, Title: "rfcs"},
+	}
+
+	tests := []struct {
+		name           string
+		existingStatus githubv4.String
+		assignments    []Assignment
+		wantAdded      []githubv4.String
+		wantFields     [][2]string
+		wantErr        string
+	}{
+		{
+			name: "adds to the matching projects and sets the fields",
+			assignments: []Assignment{
+				{ProjectNumber: 1, Status: "Todo", Fields: map[string]string{"Priority": "High", "Estimate": "3"}},
+				{ProjectNumber: 2, Title: regexp.MustCompile(`^\[RFC\]`)},
+				{ProjectNumber: 2, Labels: []string{"bug"}},
+			},
+			wantAdded:  []githubv4.String{"PVT_1"},
+			wantFields: [][2]string{{"Status", "Todo"}, {"Estimate", "3"}, {"Priority", "High"}},
+		},
+		{
+			name: "labels match ignoring case",
+			assignments: []Assignment{
+				{ProjectNumber: 2, Labels: []string{"bug", "Enhancement"}},
+			},
+			wantAdded: []githubv4.String{"PVT_2"},
+		},
+		{
+			name:           "projects which have the issue already are skipped",
+			existingStatus: "In Progress",
+			assignments: []Assignment{
+				{ProjectNumber: 3, Status: "Todo"},
+				{ProjectNumber: 1, Status: "Todo"},
+				{ProjectNumber: 1, Status: "Done"},
+			},
+			wantAdded:  []githubv4.String{"PVT_1"},
+			wantFields: [][2]string{{"Status", "Todo"}},
+		},
+		{
+			name: "existing items without a Status get the initial Status",
+			assignments: []Assignment{
+				{ProjectNumber: 3, Status: "Todo", Fields: map[string]string{"Priority": "High"}},
+				{ProjectNumber: 3, Status: "Done"},
+			},
+			wantFields: [][2]string{{"Status", "Todo"}},
+		},
+		{
+			name: "failing assignments don't stop the others",
+			assignments: []Assignment{
+				{ProjectNumber: 4},
+				{ProjectNumber: 2, Status: "Missing"},
+				{ProjectNumber: 1},
+			},
+			wantAdded:  []githubv4.String{"PVT_2", "PVT_1"},
+			wantFields: [][2]string{{"Status", "Missing"}},
+			wantErr:    "failed to assign issue to project 2: status option Missing not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issue := client.Issue{ID: "I_1", Number: 1, Title: "new status"}
+			issue.Labels.Nodes = append(issue.Labels.Nodes, struct{ Name githubv4.String }{Name: "enhancement"})
+			existing := client.ProjectV2Item{ID: "PVTI_3", Project: client.ProjectV2{ID: "PVT_3", Number: 3}}
+			existing.FieldValueByName.ProjectV2SingleSelectField.Name = tt.existingStatus
+			issue.ProjectItems.Nodes = append(issue.ProjectItems.Nodes, existing)
+
+			f := &fakes.FakeClient{}
+			f.IssueReturns(issue, nil)
+			f.ProjectCalls(func(_ context.Context, number int) (client.ProjectV2, error) {
+				if number == 3 {
+					return client.ProjectV2{ID: "PVT_3", Number: 3}, nil
+				}
+
+				project, ok := projects[number]
+				if !ok {
+					return client.ProjectV2{}, client.ErrProjectNotFound
+				}
+
+				return project, nil
+			})
+			f.AddProjectItemCalls(func(_ context.Context, projectID githubv4.String, _ githubv4.ID) (client.ProjectV2Item, error) {
+				return client.ProjectV2Item{ID: "PVTI_" + projectID}, nil
+			})
+			f.SetProjectItemFieldCalls(func(_ context.Context, _, _ githubv4.String, _, value string) error {
+				if value == "Missing" {
+					return errors.New("status option Missing not found")
+				}
+
+				return nil
+			})
+
+			err := NewAssignIssueAction(&logger.QuiteLogger{}, f, Options{
+				IssueNumber: 1,
+				Assignments: tt.assignments,
+			}).Assign(context.Background())
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				assert.ErrorIs(t, err, client.ErrProjectNotFound)
+			} else {
+				require.NoError(t, err)
+			}
+
+			var added []githubv4.String
+			for i := range f.AddProjectItemCallCount() {
+				_, projectID, contentID := f.AddProjectItemArgsForCall(i)
+				assert.Equal(t, githubv4.ID("I_1"), contentID)
+
+				added = append(added, projectID)
+			}
+
+			assert.Equal(t, tt.wantAdded, added)
+
+			var fields [][2]string
+			for i := range f.SetProjectItemFieldCallCount() {
+				_, _, _, name, value := f.SetProjectItemFieldArgsForCall(i)
+				fields = append(fields, [2]string{name, value})
+			}
+
+			assert.Equal(t, tt.wantFields, fields)
+		})
+	}
+}
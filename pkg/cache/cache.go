@@ -0,0 +1,177 @@
+// Package cache stores the results of GitHub queries which rarely change, like label, user and team IDs
+// and the fields of projects. Entries expire after a TTL and the cache can be persisted to disk, so
+// scheduled runs don't look up the same IDs again.
+package cache
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/skarlso/caretaker/pkg/logger"
+)
+
+type entry struct {
+	Value json.RawMessage `json:"value"`
+	// Expires is zero for entries which don't expire.
+	Expires time.Time `json:"expires,omitempty"`
+}
+
+// Cache is a key-value store with expiring entries. All methods are safe to use on a nil
+// cache, which doesn't store anything.
+type Cache struct {
+	ttl  time.Duration
+	path string
+	now  func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]entry
+}
+
+// New creates an in-memory cache. A ttl of zero keeps the entries until they are invalidated.
+func New(ttl time.Duration) *Cache {
+	return &Cache{
+		ttl:     ttl,
+		now:     time.Now,
+		entries: make(map[string]entry),
+	}
+}
+
+// Load creates a cache which is persisted to path by Save. Entries already in the file are loaded.
+// A missing file results in an empty cache. A corrupt file is logged and ignored, since the cache
+// only saves queries and Save overwrites it.
+func Load(log logger.Logger, path string, ttl time.Duration) (*Cache, error) {
+	c := New(ttl)
+	c.path = path
+
+	content, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return c, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cache file: %w", err)
+	}
+
+	if err := json.Unmarshal(content, &c.entries); err != nil {
+		log.Log("ignoring corrupt cache file %s: %s", path, err)
+
+		c.entries = nil
+	}
+
+	// a file containing null sets the entries to nil as well
+	if c.entries == nil {
+		c.entries = make(map[string]entry)
+	}
+
+	return c, nil
+}
+
+// Get decodes the entry of the key into v. It returns false if there is no entry or it has expired.
+func (c *Cache) Get(key string, v any) bool {
+	if c == nil {
+		return false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[key]
+	if !ok {
+		return false
+	}
+
+	if !e.Expires.IsZero() && c.now().After(e.Expires) {
+		delete(c.entries, key)
+
+		return false
+	}
+
+	return json.Unmarshal(e.Value, v) == nil
+}
+
+// Set stores v under the key. Values which can't be marshaled aren't stored.
+func (c *Cache) Set(key string, v any) {
+	if c == nil {
+		return
+	}
+
+	content, err := json.Marshal(v)
+	if err != nil {
+		return
+	}
+
+	e := entry{Value: content}
+	if c.ttl > 0 {
+		e.Expires = c.now().Add(c.ttl)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[key] = e
+}
+
+// Invalidate removes the entries of the given keys.
+func (c *Cache) Invalidate(keys ...string) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, key := range keys {
+		delete(c.entries, key)
+	}
+}
+
+// InvalidatePrefix removes all entries with keys starting with the prefix. An empty prefix clears the cache.
+func (c *Cache) InvalidatePrefix(prefix string) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key := range c.entries {
+		if strings.HasPrefix(key, prefix) {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// Save writes the entries which haven't expired to the file the cache was loaded from.
+// It's a no-op for in-memory caches.
+func (c *Cache) Save() error {
+	if c == nil || c.path == "" {
+		return nil
+	}
+
+	c.mu.Lock()
+	now := c.now()
+
+	for key, e := range c.entries {
+		if !e.Expires.IsZero() && now.After(e.Expires) {
+			delete(c.entries, key)
+		}
+	}
+
+	content, err := json.Marshal(c.entries)
+	c.mu.Unlock()
+
+	if err != nil {
+		return fmt.Errorf("failed to marshal cache: %w", err)
+	}
+
+	if err := os.WriteFile(c.path, content, 0o600); err != nil {
+		return fmt.Errorf("failed to write cache file: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package cache
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skarlso/caretaker/pkg/logger"
+)
+
+func TestCache(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	path := filepath.Join(t.TempDir(), "cache.json")
+
+	c, err := Load(&logger.QuiteLogger{}, path, time.Hour)
+	require.NoError(t, err)
+	c.now = func() time.Time { return now }
+
+	c.Set("label:bug", "LA_1")
+	c.Set("label:feature", "LA_2")
+	c.Set("user:octocat", struct{ ID string }{ID: "U_1"})
+
+	var id string
+	assert.True(t, c.Get("label:bug", &id))
+	assert.Equal(t, "LA_1", id)
+
+	c.Invalidate("label:bug")
+	assert.False(t, c.Get("label:bug", &id))
+
+	require.NoError(t, c.Save())
+
+	loaded, err := Load(&logger.QuiteLogger{}, path, time.Hour)
+	require.NoError(t, err)
+	loaded.now = func() time.Time { return now.Add(30 * time.Minute) }
+
+	var user struct{ ID string }
+	assert.True(t, loaded.Get("user:octocat", &user))
+	assert.Equal(t, "U_1", user.ID)
+
+	loaded.InvalidatePrefix("user:")
+	assert.False(t, loaded.Get("user:octocat", &user))
+
+	// entries expire after the ttl
+	loaded.now = func() time.Time { return now.Add(2 * time.Hour) }
+	assert.False(t, loaded.Get("label:feature", &id))
+
+	// a nil cache doesn't store anything
+	var none *Cache
+	none.Set("label:bug", "LA_1")
+	assert.False(t, none.Get("label:bug", &id))
+	assert.NoError(t, none.Save())
+}
+
+func TestLoad_Corrupt(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{name: "null", content: "null"},
+		{name: "truncated", content: `{"label:bug": {"value": "LA_1"}, `},
+		{name: "wrong type", content: `["label:bug"]`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "cache.json")
+			require.NoError(t, os.WriteFile(path, []byte(tt.content), 0o600))
+
+			c, err := Load(&logger.QuiteLogger{}, path, time.Hour)
+			require.NoError(t, err)
+
+			var id string
+			assert.False(t, c.Get("label:bug", &id))
+
+			c.Set("label:bug", "LA_1")
+			assert.True(t, c.Get("label:bug", &id))
+			require.NoError(t, c.Save())
+		})
+	}
+}
@@ -0,0 +1,321 @@
+package calendar
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	dateLayout    = "2006-01-02"
+	hoursInDay    = 24
+	minutesInHour = 60
+	minutesInDay  = hoursInDay * minutesInHour
+)
+
+// Calendar defines working days, working hours and holidays. It's used to measure
+// elapsed time in working time instead of wall-clock time.
+type Calendar struct {
+	workingDays map[time.Weekday]struct{}
+	// start and end are minutes since midnight.
+	start    int
+	end      int
+	location *time.Location
+	holidays map[string]struct{}
+}
+
+// Options to create a Calendar. Empty values fall back to the defaults of Default.
+type Options struct {
+	// WorkingDays is a comma separated list of days or ranges, for example, `Mon-Fri` or `Mon,Wed,Fri`.
+	WorkingDays string `yaml:"workingDays"`
+	// WorkingHours is a range of hours, for example, `09:00-17:00`.
+	WorkingHours string `yaml:"workingHours"`
+	// TimeZone is an IANA time zone name, for example, `Europe/Berlin`.
+	TimeZone string `yaml:"timeZone"`
+	// Holidays are dates in the format of 2006-01-02.
+	Holidays []string `yaml:"holidays"`
+}
+
+// IsZero returns whether no option has been set.
+func (o Options) IsZero() bool {
+	return o.WorkingDays == "" && o.WorkingHours == "" && o.TimeZone == "" && len(o.Holidays) == 0
+}
+
+// Default returns a calendar with full working days from Monday to Friday in UTC.
+func Default() *Calendar {
+	return &Calendar{
+		workingDays: map[time.Weekday]struct{}{
+			time.Monday:    {},
+			time.Tuesday:   {},
+			time.Wednesday: {},
+			time.Thursday:  {},
+			time.Friday:    {},
+		},
+		start:    0,
+		end:      minutesInDay,
+		location: time.UTC,
+		holidays: map[string]struct{}{},
+	}
+}
+
+// New creates a calendar from the given options.
+func New(opts Options) (*Calendar, error) {
+	c := Default()
+
+	if opts.WorkingDays != "" {
+		days, err := parseWeekdays(opts.WorkingDays)
+		if err != nil {
+			return nil, err
+		}
+
+		c.workingDays = days
+	}
+
+	if opts.WorkingHours != "" {
+		start, end, err := parseHours(opts.WorkingHours)
+		if err != nil {
+			return nil, err
+		}
+
+		c.start, c.end = start, end
+	}
+
+	if opts.TimeZone != "" {
+		location, err := time.LoadLocation(opts.TimeZone)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load time zone %s: %w", opts.TimeZone, err)
+		}
+
+		c.location = location
+	}
+
+	for _, holiday := range opts.Holidays {
+		holiday = strings.TrimSpace(holiday)
+		if _, err := time.Parse(dateLayout, holiday); err != nil {
+			return nil, fmt.Errorf("invalid holiday %s, wanted format YYYY-MM-DD: %w", holiday, err)
+		}
+
+		c.holidays[holiday] = struct{}{}
+	}
+
+	return c, nil
+}
+
+// WorkingDay returns the length of a single working day.
+func (c *Calendar) WorkingDay() time.Duration {
+	return time.Duration(c.end-c.start) * time.Minute
+}
+
+// Elapsed returns the working time between from and to. A nil calendar returns the wall-clock time.
+func (c *Calendar) Elapsed(from, to time.Time) time.Duration {
+	if c == nil {
+		return to.Sub(from)
+	}
+
+	if !to.After(from) {
+		return 0
+	}
+
+	from, to = from.In(c.location), to.In(c.location)
+
+	var total time.Duration
+
+	for day := midnight(from); day.Before(to); day = day.AddDate(0, 0, 1) {
+		if !c.isWorkingDay(day) {
+			continue
+		}
+
+		start := at(day, c.start)
+		end := at(day, c.end)
+
+		if from.After(start) {
+			start = from
+		}
+
+		if to.Before(end) {
+			end = to
+		}
+
+		if end.After(start) {
+			total += end.Sub(start)
+		}
+	}
+
+	return total
+}
+
+func (c *Calendar) isWorkingDay(day time.Time) bool {
+	if _, ok := c.workingDays[day.Weekday()]; !ok {
+		return false
+	}
+
+	_, holiday := c.holidays[day.Format(dateLayout)]
+
+	return !holiday
+}
+
+// ParseDuration parses a Go duration and in addition understands days, `3d`, and
+// business days, `2bd`. A business day is as long as a working day of the calendar.
+// Business days require a calendar. With a calendar, a day is a working day, too; without
+// one, it's 24 hours. Only days and business days are meant to be measured in working time,
+// see IsWorkingDuration.
+func ParseDuration(s string, c *Calendar) (time.Duration, error) {
+	switch {
+	case strings.HasSuffix(s, "bd"):
+		if c == nil {
+			return 0, fmt.Errorf("business days in %s require a working calendar", s)
+		}
+
+		n, err := strconv.ParseFloat(strings.TrimSuffix(s, "bd"), 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid number of business days in %s: %w", s, err)
+		}
+
+		return time.Duration(n * float64(c.WorkingDay())), nil
+	case strings.HasSuffix(s, "d"):
+		n, err := strconv.ParseFloat(strings.TrimSuffix(s, "d"), 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid number of days in %s: %w", s, err)
+		}
+
+		if c != nil {
+			return time.Duration(n * float64(c.WorkingDay())), nil
+		}
+
+		return time.Duration(n * float64(hoursInDay*time.Hour)), nil
+	}
+
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse duration %s: %w", s, err)
+	}
+
+	return d, nil
+}
+
+// IsBusinessDuration returns whether the duration is defined in business days.
+func IsBusinessDuration(s string) bool {
+	return strings.HasSuffix(s, "bd")
+}
+
+// IsWorkingDuration returns whether the duration is defined in days or business days. Only these
+// are measured in working time; Go durations, like the default `24h`, are always wall-clock time.
+func IsWorkingDuration(s string) bool {
+	return strings.HasSuffix(s, "d")
+}
+
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+func parseWeekday(s string) (time.Weekday, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if len(s) >= 3 {
+		s = s[:3]
+	}
+
+	day, ok := weekdays[s]
+	if !ok {
+		return 0, fmt.Errorf("unknown weekday %s", s)
+	}
+
+	return day, nil
+}
+
+func parseWeekdays(s string) (map[time.Weekday]struct{}, error) {
+	result := make(map[time.Weekday]struct{})
+
+	for _, part := range strings.Split(s, ",") {
+		from, to, isRange := strings.Cut(part, "-")
+
+		start, err := parseWeekday(from)
+		if err != nil {
+			return nil, err
+		}
+
+		end := start
+
+		if isRange {
+			if end, err = parseWeekday(to); err != nil {
+				return nil, err
+			}
+		}
+
+		// ranges can wrap around the end of the week, for example, Sun-Thu or Sat-Wed
+		for day := start; ; day = (day + 1) % 7 {
+			result[day] = struct{}{}
+
+			if day == end {
+				break
+			}
+		}
+	}
+
+	return result, nil
+}
+
+func parseHours(s string) (int, int, error) {
+	from, to, ok := strings.Cut(s, "-")
+	if !ok {
+		return 0, 0, fmt.Errorf("invalid working hours %s, wanted format HH:MM-HH:MM", s)
+	}
+
+	start, err := parseClock(from)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	end, err := parseClock(to)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if end <= start {
+		return 0, 0, errors.New("end of working hours must be after the start")
+	}
+
+	return start, end, nil
+}
+
+// parseClock returns the minutes since midnight. 24:00 is allowed to define the end of the day.
+func parseClock(s string) (int, error) {
+	hours, minutes, ok := strings.Cut(strings.TrimSpace(s), ":")
+	if !ok {
+		return 0, fmt.Errorf("invalid time %s, wanted format HH:MM", s)
+	}
+
+	h, err := strconv.Atoi(hours)
+	if err != nil {
+		return 0, fmt.Errorf("invalid hours in %s: %w", s, err)
+	}
+
+	m, err := strconv.Atoi(minutes)
+	if err != nil {
+		return 0, fmt.Errorf("invalid minutes in %s: %w", s, err)
+	}
+
+	result := h*minutesInHour + m
+	if h < 0 || m < 0 || m >= minutesInHour || result > minutesInDay {
+		return 0, fmt.Errorf("invalid time %s", s)
+	}
+
+	return result, nil
+}
+
+func midnight(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+// at returns the time of the day in minutes since midnight. Using time.Date keeps
+// the wall-clock time correct on days with a daylight saving time change.
+func at(day time.Time, minutes int) time.Time {
+	return time.Date(day.Year(), day.Month(), day.Day(), 0, minutes, 0, 0, day.Location())
+}
@@ -0,0 +1,114 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalendar_Elapsed(t *testing.T) {
+	office, err := New(Options{
+		WorkingDays:  "Mon-Fri",
+		WorkingHours: "09:00-17:00",
+		TimeZone:     "Europe/Berlin",
+		Holidays:     []string{"2024-12-25"},
+	})
+	require.NoError(t, err)
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		calendar *Calendar
+		from     time.Time
+		to       time.Time
+		want     time.Duration
+	}{
+		{
+			name: "nil calendar uses wall-clock time",
+			from: time.Date(2024, 12, 20, 12, 0, 0, 0, time.UTC),
+			to:   time.Date(2024, 12, 23, 12, 0, 0, 0, time.UTC),
+			want: 72 * time.Hour,
+		},
+		{
+			name:     "default calendar skips the weekend",
+			calendar: Default(),
+			from:     time.Date(2024, 12, 20, 12, 0, 0, 0, time.UTC), // Friday
+			to:       time.Date(2024, 12, 23, 12, 0, 0, 0, time.UTC), // Monday
+			want:     24 * time.Hour,
+		},
+		{
+			name:     "working hours and time zone",
+			calendar: office,
+			from:     time.Date(2024, 12, 20, 16, 0, 0, 0, berlin), // Friday
+			to:       time.Date(2024, 12, 23, 10, 0, 0, 0, berlin), // Monday
+			want:     2 * time.Hour,
+		},
+		{
+			name:     "holidays are skipped",
+			calendar: office,
+			from:     time.Date(2024, 12, 24, 9, 0, 0, 0, berlin),
+			to:       time.Date(2024, 12, 26, 17, 0, 0, 0, berlin),
+			want:     16 * time.Hour,
+		},
+		{
+			name:     "outside of working hours",
+			calendar: office,
+			from:     time.Date(2024, 12, 20, 18, 0, 0, 0, berlin),
+			to:       time.Date(2024, 12, 20, 23, 0, 0, 0, berlin),
+			want:     0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.calendar.Elapsed(tt.from, tt.to))
+		})
+	}
+}
+
+func TestParseDuration(t *testing.T) {
+	office, err := New(Options{WorkingHours: "09:00-17:00"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		value    string
+		calendar *Calendar
+		want     time.Duration
+		wantErr  assert.ErrorAssertionFunc
+	}{
+		{name: "go duration", value: "36h", want: 36 * time.Hour, wantErr: assert.NoError},
+		{name: "days", value: "14d", want: 14 * 24 * time.Hour, wantErr: assert.NoError},
+		{name: "days with calendar", value: "3d", calendar: office, want: 24 * time.Hour, wantErr: assert.NoError},
+		{name: "business days", value: "2bd", calendar: office, want: 16 * time.Hour, wantErr: assert.NoError},
+		{name: "business days without calendar", value: "2bd", wantErr: assert.Error},
+		{name: "invalid", value: "two days", wantErr: assert.Error},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseDuration(tt.value, tt.calendar)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestIsWorkingDuration(t *testing.T) {
+	assert.True(t, IsWorkingDuration("3d"))
+	assert.True(t, IsWorkingDuration("2bd"))
+	assert.False(t, IsWorkingDuration("24h"))
+	assert.False(t, IsWorkingDuration("1h30m"))
+}
+
+func TestNew_WorkingDays(t *testing.T) {
+	c, err := New(Options{WorkingDays: "Sun-Thu"})
+	require.NoError(t, err)
+	assert.Len(t, c.workingDays, 5)
+	assert.NotContains(t, c.workingDays, time.Friday)
+
+	_, err = New(Options{WorkingDays: "Mon-Funday"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,207 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/shurcooL/githubv4"
+)
+
+// batchableMutations are the mutations BatchingClient combines. Their results always select a field
+// which is set on success, which is used to find out which of the batched mutations failed.
+var batchableMutations = []string{
+	"updateProjectV2ItemFieldValue",
+	"addLabelsToLabelable",
+	"addComment",
+}
+
+// DefaultBatchWindow is how long BatchingClient waits for more mutations before sending a batch.
+const DefaultBatchWindow = 50 * time.Millisecond
+
+// BatchingClient combines concurrent mutations into a single GraphQL document using aliases,
+// for example, `m0: addComment(input: $input) m1: addComment(input: $input1)`. It's used together
+// with concurrent workers; a single caller waits for the window and sends its mutation alone.
+// Queries and other mutations are passed through.
+type BatchingClient struct {
+	client GraphQLClient
+	size   int
+	window time.Duration
+
+	mu      sync.Mutex
+	pending []*batchedMutation
+	timer   *time.Timer
+}
+
+type batchedMutation struct {
+	ctx   context.Context
+	m     any
+	input githubv4.Input
+	done  chan error
+}
+
+// NewBatchingClient creates a client which sends up to size mutations in one request.
+func NewBatchingClient(client GraphQLClient, size int, window time.Duration) *BatchingClient {
+	return &BatchingClient{
+		client: client,
+		size:   size,
+		window: window,
+	}
+}
+
+var _ GraphQLClient = &BatchingClient{}
+
+func (b *BatchingClient) Query(ctx context.Context, q any, variables map[string]any) error {
+	return b.client.Query(ctx, q, variables)
+}
+
+// Mutate queues the mutation and waits until the batch containing it has been sent.
+func (b *BatchingClient) Mutate(ctx context.Context, m any, input githubv4.Input, variables map[string]any) error {
+	if len(variables) > 0 || b.size < 2 || mutationField(m) == nil {
+		return b.client.Mutate(ctx, m, input, variables)
+	}
+
+	call := &batchedMutation{ctx: ctx, m: m, input: input, done: make(chan error, 1)}
+
+	b.mu.Lock()
+	b.pending = append(b.pending, call)
+
+	switch {
+	case len(b.pending) >= b.size:
+		b.flushLocked()
+	case b.timer == nil:
+		b.timer = time.AfterFunc(b.window, func() {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+
+			b.flushLocked()
+		})
+	}
+
+	b.mu.Unlock()
+
+	select {
+	case err := <-call.done:
+		return err
+	case <-ctx.Done():
+		// the mutation might still be sent as part of the batch
+		return ctx.Err()
+	}
+}
+
+// flushLocked sends the pending mutations in the background. b.mu must be held.
+func (b *BatchingClient) flushLocked() {
+	if b.timer != nil {
+		b.timer.Stop()
+		b.timer = nil
+	}
+
+	if len(b.pending) == 0 {
+		return
+	}
+
+	batch := b.pending
+	b.pending = nil
+
+	go b.send(batch)
+}
+
+func (b *BatchingClient) send(batch []*batchedMutation) {
+	// The batch outlives the context of any single caller, so it keeps the values but not the cancellation.
+	ctx := context.WithoutCancel(batch[0].ctx)
+
+	if len(batch) == 1 {
+		batch[0].done <- b.client.Mutate(ctx, batch[0].m, batch[0].input, nil)
+
+		return
+	}
+
+	combined, variables := combine(batch)
+
+	err := b.client.Mutate(ctx, combined.Interface(), batch[0].input, variables)
+	if err != nil && !isResponseError(err) {
+		// The request failed as a whole, for example, with a timeout or a bad gateway. It's unknown
+		// whether GitHub applied the mutations, so they aren't sent again, which would duplicate comments.
+		for _, call := range batch {
+			call.done <- err
+		}
+
+		return
+	}
+
+	for i, call := range batch {
+		result := combined.Elem().Field(i)
+		target := reflect.ValueOf(call.m).Elem().Field(0)
+
+		switch {
+		case err == nil:
+			target.Set(result)
+			call.done <- nil
+		case !result.IsZero():
+			// the data of the successful mutations is returned next to the errors of the failed ones
+			target.Set(result)
+			call.done <- nil
+		default:
+			// Errors don't say which alias they belong to, so the failed mutations are sent one by one
+			// to return the right error to each caller.
+			call.done <- b.client.Mutate(ctx, call.m, call.input, nil)
+		}
+	}
+}
+
+// isResponseError returns whether the error lists the errors of a GraphQL response. The response has the data of
+// the successful mutations, so a mutation without data has failed and hasn't been applied.
+func isResponseError(err error) bool {
+	// the GraphQL library doesn't export the type of the response errors
+	t := reflect.TypeOf(err)
+
+	return t.Kind() == reflect.Slice && t.PkgPath() == "github.com/shurcooL/graphql"
+}
+
+// combine creates a mutation with an aliased field for every mutation of the batch. The first
+// mutation uses $input, because it's set by Mutate, and the others $input1, $input2 and so on.
+func combine(batch []*batchedMutation) (reflect.Value, map[string]any) {
+	fields := make([]reflect.StructField, 0, len(batch))
+	variables := make(map[string]any, len(batch)-1)
+
+	for i, call := range batch {
+		field := mutationField(call.m)
+		tag := field.Tag.Get("graphql")
+
+		if i > 0 {
+			name := fmt.Sprintf("input%d", i)
+			tag = strings.Replace(tag, "$input)", "$"+name+")", 1)
+			variables[name] = call.input
+		}
+
+		fields = append(fields, reflect.StructField{
+			Name: fmt.Sprintf("M%d", i),
+			Type: field.Type,
+			Tag:  reflect.StructTag(fmt.Sprintf(`graphql:"m%d: %s"`, i, tag)),
+		})
+	}
+
+	return reflect.New(reflect.StructOf(fields)), variables
+}
+
+// mutationField returns the single field of a batchable mutation, or nil if it can't be batched.
+func mutationField(m any) *reflect.StructField {
+	t := reflect.TypeOf(m)
+	if t == nil || t.Kind() != reflect.Ptr || t.Elem().Kind() != reflect.Struct || t.Elem().NumField() != 1 {
+		return nil
+	}
+
+	field := t.Elem().Field(0)
+	tag := field.Tag.Get("graphql")
+
+	for _, name := range batchableMutations {
+		if tag == name+"(input: $input)" {
+			return &field
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,149 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type addCommentMutation struct {
+	AddComment struct {
+		Subject struct {
+			ID githubv4.ID
+		}
+	} `graphql:"addComment(input: $input)"`
+}
+
+func TestBatchingClient_Mutate(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		queries []string
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			Query     string                     `json:"query"`
+			Variables map[string]json.RawMessage `json:"variables"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+
+		mu.Lock()
+		queries = append(queries, request.Query)
+		mu.Unlock()
+
+		data := map[string]any{}
+		var errs []map[string]any
+
+		for name, raw := range request.Variables {
+			var input struct {
+				SubjectID string `json:"subjectId"`
+			}
+			require.NoError(t, json.Unmarshal(raw, &input))
+
+			result := map[string]any{"subject": map[string]any{"id": input.SubjectID}}
+			if input.SubjectID == "fail" {
+				result = nil
+				errs = append(errs, map[string]any{"message": "could not resolve subject"})
+			}
+
+			alias := "m0"
+			if name != "input" {
+				alias = "m" + strings.TrimPrefix(name, "input")
+			}
+
+			if !strings.Contains(request.Query, alias+":") {
+				// a mutation sent one by one isn't aliased
+				alias = "addComment"
+			}
+
+			data[alias] = result
+		}
+
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": data, "errors": errs}))
+	}))
+	defer server.Close()
+
+	b := NewBatchingClient(githubv4.NewEnterpriseClient(server.URL, server.Client()), 3, time.Second)
+
+	subjects := []string{"a", "fail", "c"}
+	results := make([]addCommentMutation, len(subjects))
+	errs := make([]error, len(subjects))
+
+	var wg sync.WaitGroup
+
+	for i, subject := range subjects {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			errs[i] = b.Mutate(context.Background(), &results[i], githubv4.AddCommentInput{
+				SubjectID: subject,
+				Body:      "body",
+			}, nil)
+		}()
+	}
+
+	wg.Wait()
+
+	assert.NoError(t, errs[0])
+	assert.EqualError(t, errs[1], "could not resolve subject")
+	assert.NoError(t, errs[2])
+	assert.Equal(t, githubv4.ID("a"), results[0].AddComment.Subject.ID)
+	assert.Equal(t, githubv4.ID("c"), results[2].AddComment.Subject.ID)
+
+	// one batch with all three mutations and the failed one sent again on its own
+	require.Len(t, queries, 2)
+	assert.Contains(t, queries[0], "m0: addComment(input: $input)")
+	assert.Contains(t, queries[0], "m2: addComment(input: $input2)")
+	assert.Contains(t, queries[0], "$input1:AddCommentInput!")
+	assert.NotContains(t, queries[1], "m0:")
+}
+
+func TestBatchingClient_Mutate_RequestFailed(t *testing.T) {
+	var requests atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	b := NewBatchingClient(githubv4.NewEnterpriseClient(server.URL, server.Client()), 2, time.Second)
+
+	errs := make([]error, 2)
+
+	var wg sync.WaitGroup
+
+	for i := range errs {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			errs[i] = b.Mutate(context.Background(), &addCommentMutation{}, githubv4.AddCommentInput{
+				SubjectID: "subject",
+				Body:      "body",
+			}, nil)
+		}()
+	}
+
+	wg.Wait()
+
+	// the comments might have been added, so they aren't sent again
+	for _, err := range errs {
+		assert.ErrorContains(t, err, "502 Bad Gateway")
+	}
+
+	assert.Equal(t, int32(1), requests.Load())
+}
@@ -3,9 +3,12 @@ package client
 import (
 	"context"
 	"fmt"
+	"strings"
+	"sync"
 
 	"github.com/shurcooL/githubv4"
 
+	"github.com/skarlso/caretaker/pkg/cache"
 	"github.com/skarlso/caretaker/pkg/logger"
 )
 
@@ -29,11 +32,26 @@ type PullRequest struct {
 	UpdatedAt githubv4.Date
 	Closed    githubv4.Boolean
 	Title     githubv4.String
-	Labels    struct {
+	Body      githubv4.String
+	IsDraft   githubv4.Boolean
+	Author    struct {
+		Login githubv4.String
+	}
+	BaseRefName    githubv4.String
+	HeadRefName    githubv4.String
+	ReviewDecision githubv4.PullRequestReviewDecision
+	ReviewRequests struct {
+		TotalCount githubv4.Int
+	} `graphql:"reviewRequests(first: 1)"`
+	LatestReviews struct {
+		Nodes []Review
+	} `graphql:"latestReviews(first: 10)"`
+	Labels struct {
 		Nodes []struct {
 			Name githubv4.String
 		}
 	} `graphql:"labels(first: 50)"` // We can't use Label with name because that fails if the label is not there
+	Assignees               Assignees `graphql:"assignees(first: 10)"`
 	ClosingIssuesReferences struct {
 		Nodes    []Issue
 		PageInfo struct {
@@ -85,25 +103,54 @@ type ProjectV2Item struct {
 const (
 	IssueType       = "ISSUE"
 	PullRequestType = "PULL_REQUEST"
+	DraftIssueType  = "DRAFT_ISSUE"
 )
 
 // ProjectV2ItemWithIssueContent https://docs.github.com/en/graphql/reference/objects#projectv2item
 type ProjectV2ItemWithIssueContent struct {
-	ID        githubv4.String
-	Project   ProjectV2
-	Type      githubv4.String
-	UpdatedAt githubv4.Date
-	Content   struct {
+	ID         githubv4.String
+	Project    ProjectV2
+	Type       githubv4.String
+	UpdatedAt  githubv4.Date
+	IsArchived githubv4.Boolean
+	Content    struct {
 		Issue       Issue       `graphql:"... on Issue"`
 		PullRequest PullRequest `graphql:"... on PullRequest"`
+		DraftIssue  DraftIssue  `graphql:"... on DraftIssue"`
 	}
 	FieldValueByName struct {
 		ProjectV2SingleSelectField struct {
 			Name githubv4.String
+			// UpdatedAt is when the status has been set last.
+			UpdatedAt githubv4.DateTime
 		} `graphql:"... on ProjectV2ItemFieldSingleSelectValue"`
 	} `graphql:"fieldValueByName(name: \"Status\")"`
 }
 
+// Review https://docs.github.com/en/graphql/reference/objects#pullrequestreview
+type Review struct {
+	State       githubv4.PullRequestReviewState
+	SubmittedAt githubv4.DateTime
+}
+
+type Assignees struct {
+	Nodes []struct {
+		ID    githubv4.ID
+		Login githubv4.String
+	}
+}
+
+// Has returns whether the user with the given ID is one of the assignees.
+func (a Assignees) Has(userID githubv4.ID) bool {
+	for _, n := range a.Nodes {
+		if n.ID == userID {
+			return true
+		}
+	}
+
+	return false
+}
+
 type ProjectsV2 struct {
 	Nodes []ProjectV2
 }
@@ -115,10 +162,20 @@ type ProjectItems struct {
 
 // Issue https://docs.github.com/en/graphql/reference/objects#issue
 type Issue struct {
-	ID           githubv4.ID
-	Closed       githubv4.Boolean
-	Title        githubv4.String
-	Number       githubv4.Int
+	ID        githubv4.ID
+	Closed    githubv4.Boolean
+	Title     githubv4.String
+	Number    githubv4.Int
+	UpdatedAt githubv4.Date
+	Author    struct {
+		Login githubv4.String
+	}
+	Labels struct {
+		Nodes []struct {
+			Name githubv4.String
+		}
+	} `graphql:"labels(first: 50)"`
+	Assignees    Assignees    `graphql:"assignees(first: 10)"`
 	ProjectsV2   ProjectsV2   `graphql:"projectsV2(first: 10)"`
 	ProjectItems ProjectItems `graphql:"projectItems(first: 20)"`
 }
@@ -147,13 +204,55 @@ func (i Issue) IsClosed() bool {
 	return bool(i.Closed)
 }
 
+// DraftIssue https://docs.github.com/en/graphql/reference/objects#draftissue
+type DraftIssue struct {
+	ID           githubv4.ID
+	Title        githubv4.String
+	UpdatedAt    githubv4.Date
+	ProjectsV2   ProjectsV2   `graphql:"projectsV2(first: 10)"`
+	ProjectItems ProjectItems `graphql:"projectV2Items(first: 20)"`
+}
+
+func (d DraftIssue) GetTitle() githubv4.String {
+	return d.Title
+}
+
+func (d DraftIssue) GetID() githubv4.ID {
+	return d.ID
+}
+
+// GetNumber returns 0 because draft issues don't have a number.
+func (d DraftIssue) GetNumber() githubv4.Int {
+	return 0
+}
+
+func (d DraftIssue) GetProjectsV2() ProjectsV2 {
+	return d.ProjectsV2
+}
+
+func (d DraftIssue) GetProjectItems() ProjectItems {
+	return d.ProjectItems
+}
+
+func (d DraftIssue) IsClosed() bool {
+	return false
+}
+
+// ConvertProjectV2DraftIssueItemToIssueInput is the input of convertProjectV2DraftIssueItemToIssue.
+// The type name has to match the GraphQL input type because it's used for the variable definition.
+type ConvertProjectV2DraftIssueItemToIssueInput struct {
+	// ItemID is the ID of the project item of the draft issue.
+	ItemID githubv4.ID `json:"itemId"`
+	// RepositoryID is the ID of the repository the issue is created in.
+	RepositoryID githubv4.ID `json:"repositoryId"`
+}
+
 // Comment https://docs.github.com/en/graphql/reference/objects#issuecomment
 type Comment struct {
-	ID          githubv4.ID
-	Body        githubv4.String
-	BodyText    githubv4.String
-	Issue       Issue
-	PullRequest PullRequest
+	ID              githubv4.ID
+	Body            githubv4.String
+	BodyText        githubv4.String
+	ViewerDidAuthor githubv4.Boolean
 }
 
 // User https://docs.github.com/en/graphql/reference/objects#user
@@ -161,6 +260,11 @@ type User struct {
 	ID githubv4.ID
 }
 
+// Team https://docs.github.com/en/graphql/reference/objects#team
+type Team struct {
+	ID githubv4.ID
+}
+
 // Label https://docs.github.com/en/graphql/reference/objects#label
 type Label struct{}
 
@@ -169,15 +273,38 @@ type ProjectV2 struct {
 	Title  githubv4.String
 	ID     githubv4.String
 	Number githubv4.Int
-	Field  struct {
-		ProjectV2SingleSelectField struct {
-			ID      githubv4.String
-			Options []struct {
-				ID   githubv4.String
-				Name githubv4.String
-			}
-		} `graphql:"... on ProjectV2SingleSelectField"`
-	} `graphql:"field(name: \"Status\")"` // gather the selection options for the Status field
+}
+
+// StatusField is the Status field of a project with its selection options.
+// https://docs.github.com/en/graphql/reference/objects#projectv2singleselectfield
+type StatusField struct {
+	ID      githubv4.String
+	Options []struct {
+		ID   githubv4.String
+		Name githubv4.String
+	}
+}
+
+// Option returns the ID of the option with the given name.
+func (f StatusField) Option(name githubv4.String) (githubv4.String, bool) {
+	for _, o := range f.Options {
+		if o.Name == name {
+			return o.ID, true
+		}
+	}
+
+	return "", false
+}
+
+// OptionIgnoringCase returns the ID of the option with the given name, ignoring case.
+func (f StatusField) OptionIgnoringCase(name string) (githubv4.String, bool) {
+	for _, o := range f.Options {
+		if strings.EqualFold(string(o.Name), name) {
+			return o.ID, true
+		}
+	}
+
+	return "", false
 }
 
 // GraphQLClient hides the GitHub GraphQL library.
@@ -192,27 +319,66 @@ type GraphQLClient interface {
 type Client interface {
 	AddLabel(ctx context.Context, label string, id githubv4.ID) error
 	RemoveLabel(ctx context.Context, label string, id githubv4.ID) error
-	AssignIssueToProject(ctx context.Context, issueNumber, projectNumber int) error // Consider combining these two
+	AddProjectItem(ctx context.Context, projectID githubv4.String, contentID githubv4.ID) (ProjectV2Item, error)
+	SetProjectItemField(ctx context.Context, projectID, itemID githubv4.String, name, value string) error
+	Project(ctx context.Context, projectNumber int) (ProjectV2, error)
+	Repositories(ctx context.Context) ([]string, error)
+	SearchRepositories(ctx context.Context, query string) ([]string, error)
 	AssignUserToAssignable(ctx context.Context, userID, objectID githubv4.ID) error
 	AddReaction(ctx context.Context, objectID githubv4.ID, reaction githubv4.ReactionContent) error
 	LeaveComment(ctx context.Context, prID githubv4.ID, comment string) error
+	UpdatePullRequestBody(ctx context.Context, prID githubv4.ID, body string) error
 	PullRequests(ctx context.Context) ([]PullRequest, error)
 	PullRequest(ctx context.Context, prNumber int) (PullRequest, error)
 	Issue(ctx context.Context, issueNumber int) (Issue, error)
 	ProjectItems(
 		ctx context.Context,
 		projectNumber int,
+		filter string,
 	) ([]ProjectV2ItemWithIssueContent, error)
-	UpdateIssueStatus(ctx context.Context, issue GenericIssue, statusName githubv4.String, projectNumber int) (bool, error)
+	ViewerLogin(ctx context.Context) (string, error)
+	UpdateIssueStatus(
+		ctx context.Context,
+		issue GenericIssue,
+		statusName githubv4.String,
+		projectNumber int,
+	) (StatusResult, error)
+	ClearIssueStatus(ctx context.Context, issue GenericIssue, projectNumber int) error
 	User(ctx context.Context, username string) (User, error)
+	Team(ctx context.Context, organization, slug string) (Team, error)
+	RequestReviews(ctx context.Context, prID githubv4.ID, userIDs, teamIDs []githubv4.ID) error
+	RepositoryPermission(ctx context.Context, login string) (githubv4.RepositoryPermission, error)
+	ViewerHasReacted(ctx context.Context, subjectID githubv4.ID, reaction githubv4.ReactionContent) (bool, error)
+	UnassignUserFromAssignable(ctx context.Context, userID, objectID githubv4.ID) error
+	Comments(ctx context.Context, prNumber int) ([]Comment, error)
+	UpdateComment(ctx context.Context, commentID githubv4.ID, body string) error
+	ArchiveProjectItem(ctx context.Context, projectID, itemID githubv4.ID) error
+	UnarchiveProjectItem(ctx context.Context, projectID, itemID githubv4.ID) error
+	DeleteProjectItem(ctx context.Context, projectID, itemID githubv4.ID) error
+	ConvertDraftIssue(ctx context.Context, itemID githubv4.ID, repository string) (Issue, error)
 }
 
 // Options are for Caretaker's functionality.
 type Options struct {
-	Repo           string
-	Owner          string
-	IsOrganization bool
-	MoveClosed     bool
+	Repo  string
+	Owner string
+	// IsOrganization overrides the type of the owner. If nil, it's looked up and cached.
+	IsOrganization *bool
+	// ProjectOwner owns the projects which are addressed by number. Defaults to Owner.
+	ProjectOwner string
+	// ProjectOwnerIsOrganization overrides the type of ProjectOwner. If nil, it's looked up and cached.
+	ProjectOwnerIsOrganization *bool
+	// ProjectID addresses a project by its node ID instead of the owner and the number. It's used when no
+	// project number is given; numbered projects are still looked up under the project owner.
+	ProjectID  string
+	MoveClosed bool
+	// Cache stores label, user and team IDs and the Status fields of projects. If nil, an
+	// in-memory cache is used for the run.
+	Cache *cache.Cache
+	// CreateMissingLabels creates labels which are added but don't exist in the repository.
+	CreateMissingLabels bool
+	// Strict fails status updates if a project doesn't have the requested status option instead of skipping it.
+	Strict bool
 }
 
 // Caretaker defines the main Caretaker capabilities.
@@ -221,15 +387,22 @@ type Caretaker struct {
 
 	gclient GraphQLClient
 	log     logger.Logger
+	// statusFieldsQueried holds the IDs of the projects whose Status field has been queried during this run.
+	statusFieldsQueried sync.Map
 }
 
 // NewCaretaker creates a new Caretaker with an available GitHub GraphQL client.
+// The errors of the client are classified, so they match ErrNotFound, ErrForbidden and so on.
 func NewCaretaker(log logger.Logger, gc GraphQLClient, opts Options) *Caretaker {
+	if opts.Cache == nil {
+		opts.Cache = cache.New(0)
+	}
+
 	return &Caretaker{
 		Options: opts,
 
 		log:     log,
-		gclient: gc,
+		gclient: &classifyingClient{client: gc},
 	}
 }
 
@@ -258,11 +431,12 @@ func (c *Caretaker) AddReaction(ctx context.Context, objectID githubv4.ID, react
 }
 
 func (c *Caretaker) AddLabel(ctx context.Context, label string, id githubv4.ID) error {
-	labelID, err := c.queryLabelID(ctx, label)
-	if err != nil {
-		return err
-	}
+	return c.withLabelID(ctx, label, c.CreateMissingLabels, func(labelID githubv4.ID) error {
+		return c.addLabel(ctx, labelID, id)
+	})
+}
 
+func (c *Caretaker) addLabel(ctx context.Context, labelID, id githubv4.ID) error {
 	var addLabel struct {
 		AddLabel struct {
 			Labelable struct {
@@ -286,55 +460,51 @@ func (c *Caretaker) AddLabel(ctx context.Context, label string, id githubv4.ID)
 	return nil
 }
 
-func (c *Caretaker) AssignIssueToProject(ctx context.Context, issueNumber, projectNumber int) error {
-	var getIssueQuery struct {
-		Repository struct {
-			Issue Issue `graphql:"issue(number: $issue)"`
-		} `graphql:"repository(owner: $owner, name: $name)"`
-	}
-
-	issueValues := map[string]any{
-		"owner": githubv4.String(c.Owner),
-		"name":  githubv4.String(c.Repo),
-		"issue": githubv4.Int(issueNumber),
+func (c *Caretaker) AssignUserToAssignable(ctx context.Context, userID, objectID githubv4.ID) error {
+	var addAssigneesToAssignable struct {
+		AddAssigneesToAssignable struct {
+			ClientMutationID githubv4.ID `graphql:"clientMutationId"`
+		} `graphql:"addAssigneesToAssignable(input: $input)"`
 	}
 
-	if err := c.gclient.Query(ctx, &getIssueQuery, issueValues); err != nil {
-		return fmt.Errorf("failed to find issue with number %d: %w", issueNumber, err)
+	input := githubv4.AddAssigneesToAssignableInput{
+		AssignableID: objectID,
+		AssigneeIDs:  []githubv4.ID{userID},
 	}
 
-	if c.IsOrganization {
-		return c.assignToOrganization(ctx, &getIssueQuery.Repository.Issue, projectNumber)
+	if err := c.gclient.Mutate(ctx, &addAssigneesToAssignable, input, nil); err != nil {
+		return fmt.Errorf("failed to assign user to object: %w", err)
 	}
 
-	return c.assignToUser(ctx, &getIssueQuery.Repository.Issue, projectNumber)
+	return nil
 }
 
-func (c *Caretaker) AssignUserToAssignable(ctx context.Context, userID, objectID githubv4.ID) error {
-	var addAssigneesToAssignable struct {
-		AddAssigneesToAssignable struct {
+func (c *Caretaker) UnassignUserFromAssignable(ctx context.Context, userID, objectID githubv4.ID) error {
+	var removeAssigneesFromAssignable struct {
+		RemoveAssigneesFromAssignable struct {
 			ClientMutationID githubv4.ID `graphql:"clientMutationId"`
-		} `graphql:"addAssigneesToAssignable(input: $input)"`
+		} `graphql:"removeAssigneesFromAssignable(input: $input)"`
 	}
 
-	input := githubv4.AddAssigneesToAssignableInput{
+	input := githubv4.RemoveAssigneesFromAssignableInput{
 		AssignableID: objectID,
 		AssigneeIDs:  []githubv4.ID{userID},
 	}
 
-	if err := c.gclient.Mutate(ctx, &addAssigneesToAssignable, input, nil); err != nil {
-		return fmt.Errorf("failed to assign user to object: %w", err)
+	if err := c.gclient.Mutate(ctx, &removeAssigneesFromAssignable, input, nil); err != nil {
+		return fmt.Errorf("failed to remove user from object: %w", err)
 	}
 
 	return nil
 }
 
 func (c *Caretaker) RemoveLabel(ctx context.Context, label string, id githubv4.ID) error {
-	labelID, err := c.queryLabelID(ctx, label)
-	if err != nil {
-		return err
-	}
+	return c.withLabelID(ctx, label, false, func(labelID githubv4.ID) error {
+		return c.removeLabel(ctx, labelID, id)
+	})
+}
 
+func (c *Caretaker) removeLabel(ctx context.Context, labelID, id githubv4.ID) error {
 	var removeLabel struct {
 		RemoveLabel struct {
 			Labelable struct {
@@ -458,24 +628,158 @@ func (p *projectQueryForOrganization) PageInfo() PageInfo {
 	return p.Entity.ProjectV2.Items.PageInfo
 }
 
-// query unifies the two query types for user and organization.
+type filteredProjectQueryForUser struct {
+	Entity struct {
+		ProjectV2 struct {
+			Items struct {
+				Nodes    []ProjectV2ItemWithIssueContent
+				PageInfo PageInfo
+			} `graphql:"items(first: $first, after: $after, query: $query)"`
+		} `graphql:"projectV2(number: $number)"`
+	} `graphql:"user(login: $login)"`
+}
+
+func (p *filteredProjectQueryForUser) Content() []ProjectV2ItemWithIssueContent {
+	return p.Entity.ProjectV2.Items.Nodes
+}
+
+func (p *filteredProjectQueryForUser) PageInfo() PageInfo {
+	return p.Entity.ProjectV2.Items.PageInfo
+}
+
+type filteredProjectQueryForOrganization struct {
+	Entity struct {
+		ProjectV2 struct {
+			Items struct {
+				Nodes    []ProjectV2ItemWithIssueContent
+				PageInfo PageInfo
+			} `graphql:"items(first: $first, after: $after, query: $query)"`
+		} `graphql:"projectV2(number: $number)"`
+	} `graphql:"organization(login: $login)"`
+}
+
+func (p *filteredProjectQueryForOrganization) Content() []ProjectV2ItemWithIssueContent {
+	return p.Entity.ProjectV2.Items.Nodes
+}
+
+func (p *filteredProjectQueryForOrganization) PageInfo() PageInfo {
+	return p.Entity.ProjectV2.Items.PageInfo
+}
+
+type projectQueryForNode struct {
+	Entity struct {
+		ProjectV2 struct {
+			Items struct {
+				Nodes    []ProjectV2ItemWithIssueContent
+				PageInfo PageInfo
+			} `graphql:"items(first: $first, after: $after)"`
+		} `graphql:"... on ProjectV2"`
+	} `graphql:"node(id: $id)"`
+}
+
+func (p *projectQueryForNode) Content() []ProjectV2ItemWithIssueContent {
+	return p.Entity.ProjectV2.Items.Nodes
+}
+
+func (p *projectQueryForNode) PageInfo() PageInfo {
+	return p.Entity.ProjectV2.Items.PageInfo
+}
+
+type filteredProjectQueryForNode struct {
+	Entity struct {
+		ProjectV2 struct {
+			Items struct {
+				Nodes    []ProjectV2ItemWithIssueContent
+				PageInfo PageInfo
+			} `graphql:"items(first: $first, after: $after, query: $query)"`
+		} `graphql:"... on ProjectV2"`
+	} `graphql:"node(id: $id)"`
+}
+
+func (p *filteredProjectQueryForNode) Content() []ProjectV2ItemWithIssueContent {
+	return p.Entity.ProjectV2.Items.Nodes
+}
+
+func (p *filteredProjectQueryForNode) PageInfo() PageInfo {
+	return p.Entity.ProjectV2.Items.PageInfo
+}
+
+// query unifies the query types for users, organizations and project IDs.
 type query interface {
 	Content() []ProjectV2ItemWithIssueContent
 	PageInfo() PageInfo
 }
 
-func (c *Caretaker) ProjectItems(ctx context.Context, projectNumber int) ([]ProjectV2ItemWithIssueContent, error) {
-	var projectQuery query = &projectQueryForUser{}
+// ProjectItems returns the items of a project. If a filter is given, it's passed to the API's query argument to
+// only fetch the matching items. Like the project views, the API hides archived items from filtered queries
+// unless the filter asks for them with is:archived. Without a filter, all items are returned, including archived
+// items.
+func (c *Caretaker) ProjectItems(
+	ctx context.Context,
+	projectNumber int,
+	filter string,
+) ([]ProjectV2ItemWithIssueContent, error) {
+	projectQuery, variables, err := c.projectQuery(ctx, projectNumber, filter != "")
+	if err != nil {
+		return nil, err
+	}
+
+	if filter != "" {
+		variables["query"] = githubv4.String(filter)
+	}
+
+	return c.projectItems(ctx, projectQuery, variables)
+}
+
+// projectQuery returns the query for the items of the project addressed by ProjectID, or by the number
+// under the project owner, and its variables.
+func (c *Caretaker) projectQuery(ctx context.Context, projectNumber int, filtered bool) (query, map[string]any, error) {
+	if c.byProjectID(projectNumber) {
+		var projectQuery query = &projectQueryForNode{}
+		if filtered {
+			projectQuery = &filteredProjectQueryForNode{}
+		}
+
+		// a plain string is sent as the ID type
+		return projectQuery, map[string]any{"id": c.ProjectID}, nil
+	}
+
+	isOrganization, err := c.projectOwnerIsOrganization(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var projectQuery query
 
-	if c.IsOrganization {
+	switch {
+	case isOrganization && filtered:
+		projectQuery = &filteredProjectQueryForOrganization{}
+	case isOrganization:
 		projectQuery = &projectQueryForOrganization{}
+	case filtered:
+		projectQuery = &filteredProjectQueryForUser{}
+	default:
+		projectQuery = &projectQueryForUser{}
 	}
 
-	projectValues := map[string]any{
-		"login":  githubv4.String(c.Owner),
+	return projectQuery, map[string]any{
+		"login":  githubv4.String(c.projectOwner()),
 		"number": githubv4.Int(projectNumber),
-		"first":  githubv4.Int(itemPerPage),
-		"after":  (*githubv4.String)(nil),
+	}, nil
+}
+
+func (c *Caretaker) projectItems(
+	ctx context.Context,
+	projectQuery query,
+	variables map[string]any,
+) ([]ProjectV2ItemWithIssueContent, error) {
+	projectValues := map[string]any{
+		"first": githubv4.Int(itemPerPage),
+		"after": (*githubv4.String)(nil),
+	}
+
+	for k, v := range variables {
+		projectValues[k] = v
 	}
 
 	var result []ProjectV2ItemWithIssueContent
@@ -497,134 +801,740 @@ func (c *Caretaker) ProjectItems(ctx context.Context, projectNumber int) ([]Proj
 	return result, nil
 }
 
-func (c *Caretaker) User(ctx context.Context, name string) (User, error) {
-	var user struct {
-		User User `graphql:"user(login: $name)"`
+// ViewerLogin returns the login of the authenticated user.
+func (c *Caretaker) ViewerLogin(ctx context.Context) (string, error) {
+	var viewerQuery struct {
+		Viewer struct {
+			Login githubv4.String
+		}
 	}
 
-	variables := map[string]any{
-		"name": githubv4.String(name),
+	if err := c.gclient.Query(ctx, &viewerQuery, nil); err != nil {
+		return "", fmt.Errorf("failed to get authenticated user: %w", err)
 	}
 
-	if err := c.gclient.Query(ctx, &user, variables); err != nil {
-		return User{}, fmt.Errorf("failed to get user: %w", err)
+	return string(viewerQuery.Viewer.Login), nil
+}
+
+// projectOwner returns the owner of the projects which are addressed by number.
+func (c *Caretaker) projectOwner() string {
+	if c.ProjectOwner != "" {
+		return c.ProjectOwner
 	}
 
-	return user.User, nil
+	return c.Owner
 }
 
-type GenericIssue interface {
-	GetTitle() githubv4.String
-	GetID() githubv4.ID
-	GetNumber() githubv4.Int
-	GetProjectsV2() ProjectsV2
-	GetProjectItems() ProjectItems
-	IsClosed() bool
-}
+// projectOwnerIsOrganization returns whether the owner of the projects is an organization.
+func (c *Caretaker) projectOwnerIsOrganization(ctx context.Context) (bool, error) {
+	if c.ProjectOwner != "" && !strings.EqualFold(c.ProjectOwner, c.Owner) {
+		return c.isOrganization(ctx, c.ProjectOwner, c.ProjectOwnerIsOrganization)
+	}
 
-func (c *Caretaker) UpdateIssueStatus(
-	ctx context.Context,
-	issue GenericIssue,
-	statusName githubv4.String,
-	projectNumber int,
-) (bool, error) {
-	if issue.IsClosed() && !c.MoveClosed {
-		c.log.Log("issue %s already closed, skip", issue.GetTitle())
+	return c.isOrganization(ctx, c.Owner, c.IsOrganization)
+}
 
-		return false, nil
+// isOrganization returns whether the account with the login is an organization. Unless the override is set,
+// the type of the account is looked up and cached.
+func (c *Caretaker) isOrganization(ctx context.Context, login string, override *bool) (bool, error) {
+	if override != nil {
+		return *override, nil
 	}
 
-	// mutateIssueStatus sets the Status of an Issue to the desired option.
-	var mutateIssueStatus struct {
-		UpdateProjectV2ItemFieldValue struct {
-			ProjectV2Item struct {
-				ID githubv4.String
-			} `graphql:"projectV2Item"` // value is case-sensitive and the default is projectV2item which is wrong.
-		} `graphql:"updateProjectV2ItemFieldValue(input: $input)"`
+	key := "owner-type:" + strings.ToLower(login)
+
+	var typeName string
+	if c.Cache.Get(key, &typeName) {
+		return typeName == "Organization", nil
 	}
 
-	var updated bool
+	var ownerQuery struct {
+		RepositoryOwner *struct {
+			Typename githubv4.String `graphql:"__typename"`
+		} `graphql:"repositoryOwner(login: $login)"`
+	}
 
-	for _, project := range issue.GetProjectsV2().Nodes {
-		c.log.Debug("issue number %d and title %s on project: %s", issue.GetNumber(), issue.GetTitle(), project.Title)
+	variables := map[string]any{
+		"login": githubv4.String(login),
+	}
 
-		if projectNumber > 0 && int(project.Number) != projectNumber {
-			c.log.Log("skipping project number %d as it wasn't requested for update", project.Number)
+	if err := c.gclient.Query(ctx, &ownerQuery, variables); err != nil {
+		return false, fmt.Errorf("failed to get type of owner %s: %w", login, err)
+	}
 
-			continue
-		}
+	if ownerQuery.RepositoryOwner == nil {
+		return false, &Error{Kind: ErrNotFound, Err: fmt.Errorf("owner %s not found", login)}
+	}
 
-		var projectItem ProjectV2Item
+	typeName = string(ownerQuery.RepositoryOwner.Typename)
+	c.Cache.Set(key, typeName)
 
-		// Select the right project item for the project we are checking.
-		for _, i := range issue.GetProjectItems().Nodes {
-			if i.Project.ID == project.ID {
-				projectItem = i
+	c.log.Debug("owner %s is of type %s", login, typeName)
 
-				break
-			}
-		}
+	return typeName == "Organization", nil
+}
 
-		// If there are no project items for this project that belong to this issue, it means
-		// that the issue is not assigned to this project.
-		if projectItem.ID == "" {
-			c.log.Log("ProjectItem not found for project with number %d; skipping", project.Number)
+// byProjectID reports whether the project with the number is addressed by ProjectID. The number zero, the
+// default of the flags, addresses the ProjectID project, any other number a project of the project owner.
+func (c *Caretaker) byProjectID(projectNumber int) bool {
+	return c.ProjectID != "" && projectNumber == 0
+}
 
-			continue
-		}
+// Project returns the project addressed by ProjectID, or by the number under the project owner.
+func (c *Caretaker) Project(ctx context.Context, projectNumber int) (ProjectV2, error) {
+	key := fmt.Sprintf("project:%s/%d", strings.ToLower(c.projectOwner()), projectNumber)
+	if c.byProjectID(projectNumber) {
+		key = "project:" + c.ProjectID
+	}
 
-		if projectItem.FieldValueByName.ProjectV2SingleSelectField.Name == statusName {
-			c.log.Log("ProjectItem already in request status, skipping mutation")
+	var project ProjectV2
+	if c.Cache.Get(key, &project) {
+		return project, nil
+	}
 
-			continue
-		}
+	project, err := c.queryProject(ctx, projectNumber)
+	if err != nil {
+		return ProjectV2{}, err
+	}
 
-		var option githubv4.String
+	c.Cache.Set(key, project)
 
-		for _, o := range project.Field.ProjectV2SingleSelectField.Options {
-			if o.Name == statusName {
-				option = o.ID
+	return project, nil
+}
 
-				break
-			}
+func (c *Caretaker) queryProject(ctx context.Context, projectNumber int) (ProjectV2, error) {
+	if c.byProjectID(projectNumber) {
+		var nodeQuery struct {
+			Node struct {
+				ProjectV2 ProjectV2 `graphql:"... on ProjectV2"`
+			} `graphql:"node(id: $id)"`
 		}
 
-		// This project might not have the same statuses configured. We skip setting it in that case.
-		// Note, we are doing this because an issue can be assigned to multiple projects.
-		if option == "" {
-			c.log.Log("status with name %s not found for project %d, skipping setting it", statusName, project.Number)
+		// a plain string is sent as the ID type
+		if err := c.gclient.Query(ctx, &nodeQuery, map[string]any{"id": c.ProjectID}); err != nil {
+			return ProjectV2{}, fmt.Errorf("failed to find project with id %s: %w", c.ProjectID, err)
+		}
 
-			continue
+		if nodeQuery.Node.ProjectV2.ID == "" {
+			return ProjectV2{}, &Error{Kind: ErrProjectNotFound, Err: fmt.Errorf("node %s is not a project", c.ProjectID)}
 		}
 
-		input := githubv4.UpdateProjectV2ItemFieldValueInput{
-			ProjectID: githubv4.NewString(project.ID),
-			ItemID:    githubv4.NewString(projectItem.ID),
-			FieldID:   githubv4.NewString(project.Field.ProjectV2SingleSelectField.ID),
-			Value: githubv4.ProjectV2FieldValue{
-				SingleSelectOptionID: githubv4.NewString(option),
-			},
+		return nodeQuery.Node.ProjectV2, nil
+	}
+
+	isOrganization, err := c.projectOwnerIsOrganization(ctx)
+	if err != nil {
+		return ProjectV2{}, err
+	}
+
+	variables := map[string]any{
+		"login":  githubv4.String(c.projectOwner()),
+		"number": githubv4.Int(projectNumber),
+	}
+
+	var project ProjectV2
+
+	if isOrganization {
+		var projectQuery struct {
+			Organization struct {
+				ProjectV2 ProjectV2 `graphql:"projectV2(number: $number)"`
+			} `graphql:"organization(login: $login)"`
 		}
 
-		if err := c.gclient.Mutate(ctx, &mutateIssueStatus, input, nil); err != nil {
-			return false, fmt.Errorf("failed to mutate issue: %w", err)
+		err = c.gclient.Query(ctx, &projectQuery, variables)
+		project = projectQuery.Organization.ProjectV2
+	} else {
+		var projectQuery struct {
+			User struct {
+				ProjectV2 ProjectV2 `graphql:"projectV2(number: $number)"`
+			} `graphql:"user(login: $login)"`
 		}
 
-		c.log.Log("updated status on issue %s with number %d", issue.GetTitle(), issue.GetNumber())
+		err = c.gclient.Query(ctx, &projectQuery, variables)
+		project = projectQuery.User.ProjectV2
+	}
 
-		updated = true
+	if err != nil {
+		return ProjectV2{}, fmt.Errorf(
+			"failed to find project with number %d for owner %s: %w", projectNumber, c.projectOwner(), err)
 	}
 
-	return updated, nil
+	return project, nil
 }
 
-func (c *Caretaker) LeaveComment(ctx context.Context, prID githubv4.ID, comment string) error {
-	var leaveComment struct {
-		AddComment struct {
-			Subject struct {
-				ID githubv4.ID
-			}
-		} `graphql:"addComment(input: $input)"`
+func (c *Caretaker) User(ctx context.Context, name string) (User, error) {
+	key := "user:" + strings.ToLower(name)
+
+	var cached User
+	if c.Cache.Get(key, &cached) {
+		return cached, nil
+	}
+
+	var user struct {
+		User User `graphql:"user(login: $name)"`
+	}
+
+	variables := map[string]any{
+		"name": githubv4.String(name),
+	}
+
+	if err := c.gclient.Query(ctx, &user, variables); err != nil {
+		return User{}, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	if user.User.ID != nil {
+		c.Cache.Set(key, user.User)
+	}
+
+	return user.User, nil
+}
+
+func (c *Caretaker) Team(ctx context.Context, organization, slug string) (Team, error) {
+	key := "team:" + strings.ToLower(organization+"/"+slug)
+
+	var cached Team
+	if c.Cache.Get(key, &cached) {
+		return cached, nil
+	}
+
+	var team struct {
+		Organization struct {
+			Team Team `graphql:"team(slug: $slug)"`
+		} `graphql:"organization(login: $login)"`
+	}
+
+	variables := map[string]any{
+		"login": githubv4.String(organization),
+		"slug":  githubv4.String(slug),
+	}
+
+	if err := c.gclient.Query(ctx, &team, variables); err != nil {
+		return Team{}, fmt.Errorf("failed to get team: %w", err)
+	}
+
+	if team.Organization.Team.ID == nil {
+		return Team{}, fmt.Errorf("team %s not found in organization %s", slug, organization)
+	}
+
+	c.Cache.Set(key, team.Organization.Team)
+
+	return team.Organization.Team, nil
+}
+
+func (c *Caretaker) RequestReviews(ctx context.Context, prID githubv4.ID, userIDs, teamIDs []githubv4.ID) error {
+	var requestReviews struct {
+		RequestReviews struct {
+			PullRequest struct {
+				ID githubv4.ID
+			}
+		} `graphql:"requestReviews(input: $input)"`
+	}
+
+	input := githubv4.RequestReviewsInput{
+		PullRequestID: prID,
+		Union:         githubv4.NewBoolean(true), // add to the existing reviewers instead of replacing them
+	}
+
+	if len(userIDs) > 0 {
+		input.UserIDs = &userIDs
+	}
+
+	if len(teamIDs) > 0 {
+		input.TeamIDs = &teamIDs
+	}
+
+	if err := c.gclient.Mutate(ctx, &requestReviews, input, nil); err != nil {
+		return fmt.Errorf("failed to request reviews on pull request: %w", err)
+	}
+
+	c.log.Debug("requested reviews from %d users and %d teams", len(userIDs), len(teamIDs))
+
+	return nil
+}
+
+// ViewerHasReacted returns whether the authenticated user already left the given reaction on the comment.
+func (c *Caretaker) ViewerHasReacted(
+	ctx context.Context,
+	subjectID githubv4.ID,
+	reaction githubv4.ReactionContent,
+) (bool, error) {
+	var queryReactions struct {
+		Node struct {
+			IssueComment struct {
+				ReactionGroups []struct {
+					Content          githubv4.ReactionContent
+					ViewerHasReacted githubv4.Boolean
+				}
+			} `graphql:"... on IssueComment"`
+		} `graphql:"node(id: $id)"`
+	}
+
+	variables := map[string]any{
+		"id": subjectID,
+	}
+
+	if err := c.gclient.Query(ctx, &queryReactions, variables); err != nil {
+		return false, fmt.Errorf("failed to query reactions of comment: %w", err)
+	}
+
+	for _, group := range queryReactions.Node.IssueComment.ReactionGroups {
+		if group.Content == reaction {
+			return bool(group.ViewerHasReacted), nil
+		}
+	}
+
+	return false, nil
+}
+
+// RepositoryPermission returns the permission the user has on the repository. If the user isn't
+// a collaborator, an empty permission is returned.
+func (c *Caretaker) RepositoryPermission(ctx context.Context, login string) (githubv4.RepositoryPermission, error) {
+	var queryCollaborators struct {
+		Repository struct {
+			Collaborators struct {
+				Edges []struct {
+					Permission githubv4.RepositoryPermission
+					Node       struct {
+						Login githubv4.String
+					}
+				}
+			} `graphql:"collaborators(query: $login, first: 10)"` // query is a fuzzy search, so we match below
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
+
+	variables := map[string]any{
+		"owner": githubv4.String(c.Owner),
+		"name":  githubv4.String(c.Repo),
+		"login": githubv4.String(login),
+	}
+
+	if err := c.gclient.Query(ctx, &queryCollaborators, variables); err != nil {
+		return "", fmt.Errorf("failed to query collaborators: %w", err)
+	}
+
+	for _, edge := range queryCollaborators.Repository.Collaborators.Edges {
+		if strings.EqualFold(string(edge.Node.Login), login) {
+			return edge.Permission, nil
+		}
+	}
+
+	return "", nil
+}
+
+type GenericIssue interface {
+	GetTitle() githubv4.String
+	GetID() githubv4.ID
+	GetNumber() githubv4.Int
+	GetProjectsV2() ProjectsV2
+	GetProjectItems() ProjectItems
+	IsClosed() bool
+}
+
+// UpdateIssueStatus sets the Status of the issue in all of its projects, or only in the project with the given
+// number if it's greater than zero, or in the project with ProjectID. The result lists the outcome for every
+// project of the issue. In strict mode, projects which don't have the requested status option are reported as
+// errors after the others have been updated.
+func (c *Caretaker) UpdateIssueStatus(
+	ctx context.Context,
+	issue GenericIssue,
+	statusName githubv4.String,
+	projectNumber int,
+) (StatusResult, error) {
+	var result StatusResult
+
+	requested, err := c.requestedProject(ctx, projectNumber)
+	if err != nil {
+		return result, err
+	}
+
+	for _, project := range issue.GetProjectsV2().Nodes {
+		c.log.Debug("issue number %d and title %s on project: %s", issue.GetNumber(), issue.GetTitle(), project.Title)
+
+		projectResult, err := c.updateProjectStatus(ctx, issue, project, statusName, requested)
+		if err != nil {
+			return result, err
+		}
+
+		result.Projects = append(result.Projects, projectResult)
+	}
+
+	if c.Strict {
+		return result, result.MissingOptions(string(statusName))
+	}
+
+	return result, nil
+}
+
+// requestedProject returns the project a status update is limited to. It's empty if all projects are updated.
+// Projects of the owner are matched by number, projects of a different owner or with ProjectID are looked up and
+// matched by ID, because the issue can be in projects of several owners with the same number.
+func (c *Caretaker) requestedProject(ctx context.Context, projectNumber int) (ProjectV2, error) {
+	crossOwner := c.ProjectOwner != "" && !strings.EqualFold(c.ProjectOwner, c.Owner)
+
+	switch {
+	case c.byProjectID(projectNumber) || (crossOwner && projectNumber > 0):
+		return c.Project(ctx, projectNumber)
+	case projectNumber > 0:
+		return ProjectV2{Number: githubv4.Int(projectNumber)}, nil
+	default:
+		return ProjectV2{}, nil
+	}
+}
+
+// updateProjectStatus sets the Status of the issue in a single project.
+func (c *Caretaker) updateProjectStatus(
+	ctx context.Context,
+	issue GenericIssue,
+	project ProjectV2,
+	statusName githubv4.String,
+	requested ProjectV2,
+) (ProjectStatusResult, error) {
+	result := ProjectStatusResult{
+		ProjectNumber: int(project.Number),
+		ProjectTitle:  string(project.Title),
+	}
+
+	if (requested.ID != "" && project.ID != requested.ID) || (requested.Number > 0 && project.Number != requested.Number) {
+		c.log.Log("skipping project number %d as it wasn't requested for update", project.Number)
+		result.Outcome = StatusSkippedNotRequested
+
+		return result, nil
+	}
+
+	if issue.IsClosed() && !c.MoveClosed {
+		c.log.Log("issue %s already closed, skip", issue.GetTitle())
+		result.Outcome = StatusSkippedClosed
+
+		return result, nil
+	}
+
+	var projectItem ProjectV2Item
+
+	// Select the right project item for the project we are checking.
+	for _, i := range issue.GetProjectItems().Nodes {
+		if i.Project.ID == project.ID {
+			projectItem = i
+
+			break
+		}
+	}
+
+	// If there are no project items for this project that belong to this issue, it means
+	// that the issue is not assigned to this project.
+	if projectItem.ID == "" {
+		c.log.Log("ProjectItem not found for project with number %d; skipping", project.Number)
+		result.Outcome = StatusSkippedNotInProject
+
+		return result, nil
+	}
+
+	result.FromStatus = string(projectItem.FieldValueByName.ProjectV2SingleSelectField.Name)
+
+	if projectItem.FieldValueByName.ProjectV2SingleSelectField.Name == statusName {
+		c.log.Log("ProjectItem already in request status, skipping mutation")
+		result.Outcome = StatusSkippedAlreadySet
+
+		return result, nil
+	}
+
+	field, option, err := c.statusOption(ctx, project.ID, statusName)
+	if err != nil {
+		return result, err
+	}
+
+	if option != "" {
+		err = c.setStatus(ctx, project.ID, projectItem.ID, field.ID, option)
+		if err != nil && isStaleIDError(err) {
+			// The Status field has changed since it has been cached, so it's looked up again.
+			c.log.Debug("status field of project %d is stale, looking it up again", project.Number)
+			c.Cache.Invalidate(statusFieldKey(project.ID))
+
+			if field, option, err = c.statusOption(ctx, project.ID, statusName); err != nil {
+				return result, err
+			}
+
+			if option != "" {
+				err = c.setStatus(ctx, project.ID, projectItem.ID, field.ID, option)
+			}
+		}
+
+		if err != nil {
+			return result, fmt.Errorf("failed to mutate issue: %w", err)
+		}
+	}
+
+	// This project might not have the same statuses configured. We skip setting it in that case.
+	// Note, we are doing this because an issue can be assigned to multiple projects.
+	if option == "" {
+		c.log.Log("status with name %s not found for project %d, skipping setting it", statusName, project.Number)
+		result.Outcome = StatusSkippedOptionNotFound
+
+		for _, o := range field.Options {
+			result.Options = append(result.Options, string(o.Name))
+		}
+
+		return result, nil
+	}
+
+	c.log.Log("updated status on issue %s with number %d", issue.GetTitle(), issue.GetNumber())
+	result.Outcome = StatusUpdated
+
+	return result, nil
+}
+
+// setStatus sets the Status of a project item to the desired option.
+func (c *Caretaker) setStatus(ctx context.Context, projectID, itemID, fieldID, option githubv4.String) error {
+	var mutateIssueStatus struct {
+		UpdateProjectV2ItemFieldValue struct {
+			ProjectV2Item struct {
+				ID githubv4.String
+			} `graphql:"projectV2Item"` // value is case-sensitive and the default is projectV2item which is wrong.
+		} `graphql:"updateProjectV2ItemFieldValue(input: $input)"`
+	}
+
+	input := githubv4.UpdateProjectV2ItemFieldValueInput{
+		ProjectID: githubv4.NewString(projectID),
+		ItemID:    githubv4.NewString(itemID),
+		FieldID:   githubv4.NewString(fieldID),
+		Value: githubv4.ProjectV2FieldValue{
+			SingleSelectOptionID: githubv4.NewString(option),
+		},
+	}
+
+	return c.gclient.Mutate(ctx, &mutateIssueStatus, input, nil)
+}
+
+// ClearIssueStatus clears the Status of the issue in its projects with the number, for example, to undo setting
+// the first Status. Projects in which the issue has no Status are left alone.
+func (c *Caretaker) ClearIssueStatus(ctx context.Context, issue GenericIssue, projectNumber int) error {
+	for _, item := range issue.GetProjectItems().Nodes {
+		if int(item.Project.Number) != projectNumber || item.FieldValueByName.ProjectV2SingleSelectField.Name == "" {
+			continue
+		}
+
+		field, err := c.statusField(ctx, item.Project.ID)
+		if err != nil {
+			return err
+		}
+
+		var clearFieldValue struct {
+			ClearProjectV2ItemFieldValue struct {
+				ProjectV2Item struct {
+					ID githubv4.String
+				} `graphql:"projectV2Item"`
+			} `graphql:"clearProjectV2ItemFieldValue(input: $input)"`
+		}
+
+		input := githubv4.ClearProjectV2ItemFieldValueInput{
+			ProjectID: githubv4.ID(item.Project.ID),
+			ItemID:    githubv4.ID(item.ID),
+			FieldID:   githubv4.ID(field.ID),
+		}
+
+		if err := c.gclient.Mutate(ctx, &clearFieldValue, input, nil); err != nil {
+			return fmt.Errorf("failed to clear status: %w", err)
+		}
+
+		c.log.Log("cleared status on issue %s with number %d", issue.GetTitle(), issue.GetNumber())
+	}
+
+	return nil
+}
+
+func statusFieldKey(projectID githubv4.String) string {
+	return fmt.Sprintf("project-status-field:%s", projectID)
+}
+
+// statusOption returns the Status field of the project and the ID of the option with the given
+// name. The option is empty if the project doesn't have it. If the option is missing from the
+// cached field, the field is looked up again in case the option has been added since, but only
+// once per run, because the projects of an issue might not have the same statuses.
+func (c *Caretaker) statusOption(
+	ctx context.Context,
+	projectID, name githubv4.String,
+) (StatusField, githubv4.String, error) {
+	var field StatusField
+	if c.Cache.Get(statusFieldKey(projectID), &field) {
+		if option, ok := field.Option(name); ok {
+			return field, option, nil
+		}
+
+		if _, queried := c.statusFieldsQueried.Load(projectID); queried {
+			return field, "", nil
+		}
+	}
+
+	field, err := c.queryStatusField(ctx, projectID)
+	if err != nil {
+		return StatusField{}, "", err
+	}
+
+	option, _ := field.Option(name)
+
+	return field, option, nil
+}
+
+// statusField returns the Status field of the project from the cache or looks it up.
+func (c *Caretaker) statusField(ctx context.Context, projectID githubv4.String) (StatusField, error) {
+	var field StatusField
+	if c.Cache.Get(statusFieldKey(projectID), &field) {
+		return field, nil
+	}
+
+	return c.queryStatusField(ctx, projectID)
+}
+
+// queryStatusField looks up the Status field of the project and caches it.
+func (c *Caretaker) queryStatusField(ctx context.Context, projectID githubv4.String) (StatusField, error) {
+	var statusFieldQuery struct {
+		Node struct {
+			ProjectV2 struct {
+				Field struct {
+					StatusField StatusField `graphql:"... on ProjectV2SingleSelectField"`
+				} `graphql:"field(name: \"Status\")"`
+			} `graphql:"... on ProjectV2"`
+		} `graphql:"node(id: $id)"`
+	}
+
+	if err := c.gclient.Query(ctx, &statusFieldQuery, map[string]any{
+		// a plain string is sent as the ID type
+		"id": string(projectID),
+	}); err != nil {
+		return StatusField{}, fmt.Errorf("failed to get status field of project: %w", err)
+	}
+
+	field := statusFieldQuery.Node.ProjectV2.Field.StatusField
+	c.Cache.Set(statusFieldKey(projectID), field)
+	c.statusFieldsQueried.Store(projectID, struct{}{})
+
+	return field, nil
+}
+
+// isStaleIDError returns whether the error is caused by an ID which doesn't exist anymore,
+// for example, because a label or a status option has been deleted and recreated.
+func isStaleIDError(err error) bool {
+	return containsAny(err.Error(), staleIDMessages)
+}
+
+// ArchiveProjectItem archives an item of a project. Archived items are hidden from the project's views.
+func (c *Caretaker) ArchiveProjectItem(ctx context.Context, projectID, itemID githubv4.ID) error {
+	var archiveProjectV2Item struct {
+		ArchiveProjectV2Item struct {
+			Item struct {
+				ID githubv4.ID
+			}
+		} `graphql:"archiveProjectV2Item(input: $input)"`
+	}
+
+	input := githubv4.ArchiveProjectV2ItemInput{
+		ProjectID: projectID,
+		ItemID:    itemID,
+	}
+
+	if err := c.gclient.Mutate(ctx, &archiveProjectV2Item, input, nil); err != nil {
+		return fmt.Errorf("failed to archive project item: %w", err)
+	}
+
+	return nil
+}
+
+// UnarchiveProjectItem restores an archived item of a project.
+func (c *Caretaker) UnarchiveProjectItem(ctx context.Context, projectID, itemID githubv4.ID) error {
+	var unarchiveProjectV2Item struct {
+		UnarchiveProjectV2Item struct {
+			Item struct {
+				ID githubv4.ID
+			}
+		} `graphql:"unarchiveProjectV2Item(input: $input)"`
+	}
+
+	input := githubv4.UnarchiveProjectV2ItemInput{
+		ProjectID: projectID,
+		ItemID:    itemID,
+	}
+
+	if err := c.gclient.Mutate(ctx, &unarchiveProjectV2Item, input, nil); err != nil {
+		return fmt.Errorf("failed to unarchive project item: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteProjectItem removes an item from a project. The issue or pull request itself isn't deleted.
+func (c *Caretaker) DeleteProjectItem(ctx context.Context, projectID, itemID githubv4.ID) error {
+	var deleteProjectV2Item struct {
+		DeleteProjectV2Item struct {
+			DeletedItemID githubv4.ID `graphql:"deletedItemId"`
+		} `graphql:"deleteProjectV2Item(input: $input)"`
+	}
+
+	input := githubv4.DeleteProjectV2ItemInput{
+		ProjectID: projectID,
+		ItemID:    itemID,
+	}
+
+	if err := c.gclient.Mutate(ctx, &deleteProjectV2Item, input, nil); err != nil {
+		return fmt.Errorf("failed to delete project item: %w", err)
+	}
+
+	return nil
+}
+
+// ConvertDraftIssue converts the draft issue of a project item into an issue. The repository is
+// either owner/name, name in the configured owner's account or empty for the configured repository.
+func (c *Caretaker) ConvertDraftIssue(ctx context.Context, itemID githubv4.ID, repository string) (Issue, error) {
+	owner, name := c.Owner, c.Repo
+
+	if repository != "" {
+		name = repository
+
+		if o, n, ok := strings.Cut(repository, "/"); ok {
+			owner, name = o, n
+		}
+	}
+
+	var repositoryQuery struct {
+		Repository struct {
+			ID githubv4.ID
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
+
+	if err := c.gclient.Query(ctx, &repositoryQuery, map[string]any{
+		"owner": githubv4.String(owner),
+		"name":  githubv4.String(name),
+	}); err != nil {
+		return Issue{}, fmt.Errorf("failed to find repository %s/%s: %w", owner, name, err)
+	}
+
+	var convertDraftIssue struct {
+		ConvertProjectV2DraftIssueItemToIssue struct {
+			Item struct {
+				Content struct {
+					Issue Issue `graphql:"... on Issue"`
+				}
+			}
+		} `graphql:"convertProjectV2DraftIssueItemToIssue(input: $input)"`
+	}
+
+	input := ConvertProjectV2DraftIssueItemToIssueInput{
+		ItemID:       itemID,
+		RepositoryID: repositoryQuery.Repository.ID,
+	}
+
+	if err := c.gclient.Mutate(ctx, &convertDraftIssue, input, nil); err != nil {
+		return Issue{}, fmt.Errorf("failed to convert draft issue: %w", err)
+	}
+
+	return convertDraftIssue.ConvertProjectV2DraftIssueItemToIssue.Item.Content.Issue, nil
+}
+
+func (c *Caretaker) LeaveComment(ctx context.Context, prID githubv4.ID, comment string) error {
+	var leaveComment struct {
+		AddComment struct {
+			Subject struct {
+				ID githubv4.ID
+			}
+		} `graphql:"addComment(input: $input)"`
 	}
 
 	input := githubv4.AddCommentInput{
@@ -641,97 +1551,218 @@ func (c *Caretaker) LeaveComment(ctx context.Context, prID githubv4.ID, comment
 	return nil
 }
 
-func (c *Caretaker) queryLabelID(ctx context.Context, label string) (githubv4.ID, error) {
+// Comments returns the last 100 comments of a pull request.
+func (c *Caretaker) Comments(ctx context.Context, prNumber int) ([]Comment, error) {
+	var queryComments struct {
+		Repository struct {
+			PullRequest struct {
+				Comments struct {
+					Nodes []Comment
+				} `graphql:"comments(last: 100)"`
+			} `graphql:"pullRequest(number: $pullNumber)"`
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
+
 	variables := map[string]any{
-		"owner": githubv4.String(c.Owner),
-		"name":  githubv4.String(c.Repo),
-		"query": githubv4.String(label),
+		"owner":      githubv4.String(c.Owner),
+		"name":       githubv4.String(c.Repo),
+		"pullNumber": githubv4.Int(prNumber),
 	}
 
-	var queryLabelID struct {
-		Repository struct {
-			Labels struct {
-				Nodes []struct {
-					ID githubv4.ID
-				}
-			} `graphql:"labels(first: 1, query: $query)"` // There Can Be Only One!
-		} `graphql:"repository(owner: $owner, name: $name)"`
+	if err := c.gclient.Query(ctx, &queryComments, variables); err != nil {
+		return nil, fmt.Errorf("failed to get comments of pull request: %w", err)
+	}
+
+	return queryComments.Repository.PullRequest.Comments.Nodes, nil
+}
+
+func (c *Caretaker) UpdateComment(ctx context.Context, commentID githubv4.ID, body string) error {
+	var updateComment struct {
+		UpdateIssueComment struct {
+			IssueComment struct {
+				ID githubv4.ID
+			}
+		} `graphql:"updateIssueComment(input: $input)"`
 	}
 
-	if err := c.gclient.Query(ctx, &queryLabelID, variables); err != nil {
-		return "", fmt.Errorf("failed to query for label id: %w", err)
+	input := githubv4.UpdateIssueCommentInput{
+		ID:   commentID,
+		Body: githubv4.String(body),
 	}
 
-	if len(queryLabelID.Repository.Labels.Nodes) != 1 {
-		return "", fmt.Errorf("expected a single label to be returned, got: %d", len(queryLabelID.Repository.Labels.Nodes))
+	if err := c.gclient.Mutate(ctx, &updateComment, input, nil); err != nil {
+		return fmt.Errorf("failed to update comment: %w", err)
 	}
 
-	return queryLabelID.Repository.Labels.Nodes[0].ID, nil
+	return nil
 }
 
-func (c *Caretaker) assignToOrganization(ctx context.Context, issue *Issue, projectNumber int) error {
-	projectValues := map[string]any{
-		"login":  githubv4.String(c.Owner),
-		"number": githubv4.Int(projectNumber),
+func (c *Caretaker) UpdatePullRequestBody(ctx context.Context, prID githubv4.ID, body string) error {
+	var updatePullRequest struct {
+		UpdatePullRequest struct {
+			PullRequest struct {
+				ID githubv4.ID
+			}
+		} `graphql:"updatePullRequest(input: $input)"`
 	}
 
-	var projectQuery struct {
-		Organization struct {
-			ProjectV2 ProjectV2 `graphql:"projectV2(number: $number)"`
-		} `graphql:"organization(login: $login)"`
+	input := githubv4.UpdatePullRequestInput{
+		PullRequestID: prID,
+		Body:          githubv4.NewString(githubv4.String(body)),
 	}
 
-	if err := c.gclient.Query(ctx, &projectQuery, projectValues); err != nil {
-		return fmt.Errorf("failed to find project with number %d for owner %s: %w", projectNumber, c.Owner, err)
+	if err := c.gclient.Mutate(ctx, &updatePullRequest, input, nil); err != nil {
+		return fmt.Errorf("failed to update pull request body: %w", err)
 	}
 
-	return c.assignIssueToProject(ctx, projectQuery.Organization.ProjectV2, issue)
+	c.log.Debug("updated body of pull request with ID %s", prID)
+
+	return nil
 }
 
-func (c *Caretaker) assignToUser(ctx context.Context, issue *Issue, projectNumber int) error {
-	projectValues := map[string]any{
-		"login":      githubv4.String(c.Owner),
-		"number":     githubv4.Int(projectNumber),
-		"statusName": githubv4.String(""),
+// withLabelID runs the mutation with the ID of the label. If the cached ID is stale, for example,
+// because the label has been recreated, it's looked up again and the mutation is retried once.
+// If create is set, a missing label is created.
+func (c *Caretaker) withLabelID(
+	ctx context.Context,
+	label string,
+	create bool,
+	mutate func(labelID githubv4.ID) error,
+) error {
+	labelID, err := c.labelID(ctx, label, create)
+	if err != nil {
+		return err
+	}
+
+	err = mutate(labelID)
+	if err == nil || !isStaleIDError(err) {
+		return err
+	}
+
+	c.log.Debug("label ID of %s is stale, looking it up again", label)
+	c.Cache.Invalidate(c.labelKey(label))
+
+	if labelID, err = c.labelID(ctx, label, create); err != nil {
+		return err
+	}
+
+	return mutate(labelID)
+}
+
+func (c *Caretaker) labelKey(label string) string {
+	return fmt.Sprintf("label:%s/%s:%s", c.Owner, c.Repo, strings.ToLower(label))
+}
+
+// labelID returns the ID of the label with exactly the given name, ignoring case. If the label
+// doesn't exist, it's created if create is set, otherwise a *LabelNotFoundError is returned.
+func (c *Caretaker) labelID(ctx context.Context, label string, create bool) (githubv4.ID, error) {
+	var id string
+	if c.Cache.Get(c.labelKey(label), &id) {
+		return id, nil
+	}
+
+	labelID, repositoryID, similar, err := c.queryLabelID(ctx, label)
+	if err != nil {
+		return nil, err
+	}
+
+	if labelID == nil {
+		if !create {
+			return nil, &LabelNotFoundError{Label: label, Similar: similar}
+		}
+
+		if labelID, err = c.createLabel(ctx, repositoryID, label); err != nil {
+			return nil, err
+		}
+	}
+
+	c.Cache.Set(c.labelKey(label), labelID)
+
+	return labelID, nil
+}
+
+// queryLabelID searches the labels of the repository. The search is fuzzy, searching for `bug`
+// also returns `bug-report`, so the results are compared to the name. It returns a nil ID and the
+// names of the found labels if none of them matches.
+func (c *Caretaker) queryLabelID(
+	ctx context.Context,
+	label string,
+) (labelID, repositoryID githubv4.ID, similar []string, err error) {
+	variables := map[string]any{
+		"owner": githubv4.String(c.Owner),
+		"name":  githubv4.String(c.Repo),
+		"query": githubv4.String(label),
+		"after": (*githubv4.String)(nil),
 	}
 
-	var projectQuery struct {
-		User struct {
-			ProjectV2 ProjectV2 `graphql:"projectV2(number: $number)"`
-		} `graphql:"user(login: $login)"`
+	var queryLabelID struct {
+		Repository struct {
+			ID     githubv4.ID
+			Labels struct {
+				Nodes []struct {
+					ID   githubv4.ID
+					Name githubv4.String
+				}
+				PageInfo PageInfo
+			} `graphql:"labels(first: 100, after: $after, query: $query)"`
+		} `graphql:"repository(owner: $owner, name: $name)"`
 	}
 
-	if err := c.gclient.Query(ctx, &projectQuery, projectValues); err != nil {
-		return fmt.Errorf("failed to find project with number %d for owner %s: %w", projectNumber, c.Owner, err)
+	for {
+		if err := c.gclient.Query(ctx, &queryLabelID, variables); err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to query for label id: %w", err)
+		}
+
+		for _, n := range queryLabelID.Repository.Labels.Nodes {
+			if strings.EqualFold(string(n.Name), label) {
+				return n.ID, queryLabelID.Repository.ID, nil, nil
+			}
+
+			similar = append(similar, string(n.Name))
+		}
+
+		if !queryLabelID.Repository.Labels.PageInfo.HasNextPage {
+			break
+		}
+
+		variables["after"] = githubv4.NewString(queryLabelID.Repository.Labels.PageInfo.EndCursor)
 	}
 
-	return c.assignIssueToProject(ctx, projectQuery.User.ProjectV2, issue)
+	return nil, queryLabelID.Repository.ID, similar, nil
 }
 
-func (c *Caretaker) assignIssueToProject(ctx context.Context, project ProjectV2, issue *Issue) error {
-	c.log.Log(
-		"assigning issue number %d with title %s to project number %d and title %s",
-		issue.Number,
-		issue.Title,
-		project.Number,
-		project.Title,
-	)
+// CreateLabelInput is the input of createLabel.
+// The type name has to match the GraphQL input type because it's used for the variable definition.
+type CreateLabelInput struct {
+	RepositoryID githubv4.ID     `json:"repositoryId"`
+	Name         githubv4.String `json:"name"`
+	// Color is a hex code without the leading #.
+	Color githubv4.String `json:"color"`
+}
 
-	// mutateIssue sets the Status of an Issue to the desired option.
-	var addProjectV2ItemByID struct {
-		AddProjectV2ItemById struct { //nolint:stylecheck,revive // this needs to be Id.
-			Item ProjectV2Item
-		} `graphql:"addProjectV2ItemById(input: $input)"`
+// defaultLabelColor is the color of the labels Caretaker creates.
+const defaultLabelColor = "ededed"
+
+func (c *Caretaker) createLabel(ctx context.Context, repositoryID githubv4.ID, label string) (githubv4.ID, error) {
+	var createLabel struct {
+		CreateLabel struct {
+			Label struct {
+				ID githubv4.ID
+			}
+		} `graphql:"createLabel(input: $input)"`
 	}
 
-	input := githubv4.AddProjectV2ItemByIdInput{
-		ProjectID: project.ID,
-		ContentID: issue.ID,
+	input := CreateLabelInput{
+		RepositoryID: repositoryID,
+		Name:         githubv4.String(label),
+		Color:        defaultLabelColor,
 	}
 
-	if err := c.gclient.Mutate(ctx, &addProjectV2ItemByID, input, nil); err != nil {
-		return fmt.Errorf("failed to assign issue to project: %w", err)
+	if err := c.gclient.Mutate(ctx, &createLabel, input, nil); err != nil {
+		return nil, fmt.Errorf("failed to create label %s: %w", label, err)
 	}
 
-	return nil
+	c.log.Log("created missing label %s", label)
+
+	return createLabel.CreateLabel.Label.ID, nil
 }
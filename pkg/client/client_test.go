@@ -0,0 +1,21 @@
+package client_test
+
+import (
+	"testing"
+
+	"github.com/shurcooL/githubv4"
+
+	"github.com/skarlso/caretaker/pkg/client"
+	"github.com/skarlso/caretaker/pkg/githubtest"
+	"github.com/skarlso/caretaker/pkg/logger"
+)
+
+// newCaretaker starts a fake GitHub API and returns a client talking to it.
+func newCaretaker(t *testing.T, opts client.Options) (*githubtest.Server, *client.Caretaker) {
+	t.Helper()
+
+	s := githubtest.NewServer()
+	t.Cleanup(s.Close)
+
+	return s, client.NewCaretaker(&logger.QuiteLogger{}, githubv4.NewEnterpriseClient(s.URL, s.Client()), opts)
+}
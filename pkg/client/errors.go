@@ -0,0 +1,163 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/shurcooL/githubv4"
+)
+
+var (
+	// ErrNotFound is returned if an object doesn't exist or isn't visible to the token.
+	ErrNotFound = errors.New("not found")
+	// ErrForbidden is returned if the token lacks a permission or scope.
+	ErrForbidden = errors.New("forbidden")
+	// ErrRateLimited is returned if the primary or a secondary rate limit has been exceeded.
+	ErrRateLimited = errors.New("rate limited")
+	// ErrProjectNotFound is returned if a project doesn't exist. It matches ErrNotFound.
+	ErrProjectNotFound = fmt.Errorf("project %w", ErrNotFound)
+	// ErrStatusOptionNotFound is returned if the Status field of a project doesn't have the requested option.
+	// It matches ErrNotFound.
+	ErrStatusOptionNotFound = fmt.Errorf("status option %w", ErrNotFound)
+	// ErrLabelNotFound is returned if a label doesn't exist in the repository. It matches ErrNotFound.
+	ErrLabelNotFound = fmt.Errorf("label %w", ErrNotFound)
+)
+
+// Error is an error of the GitHub API classified into one of the sentinel errors.
+// Its message is the original message, errors.Is matches both Kind and Err.
+type Error struct {
+	Kind error
+	Err  error
+}
+
+func (e *Error) Error() string {
+	return e.Err.Error()
+}
+
+func (e *Error) Unwrap() []error {
+	return []error{e.Kind, e.Err}
+}
+
+// LabelNotFoundError lists the labels which are similar to the missing label. It matches ErrLabelNotFound.
+type LabelNotFoundError struct {
+	Label   string
+	Similar []string
+}
+
+func (e *LabelNotFoundError) Error() string {
+	if len(e.Similar) == 0 {
+		return fmt.Sprintf("label %q not found", e.Label)
+	}
+
+	return fmt.Sprintf("label %q not found, similar labels are: %s", e.Label, strings.Join(e.Similar, ", "))
+}
+
+func (e *LabelNotFoundError) Unwrap() error {
+	return ErrLabelNotFound
+}
+
+// StatusOptionNotFoundError lists the options of the project's Status field. It matches ErrStatusOptionNotFound.
+type StatusOptionNotFoundError struct {
+	Status        string
+	ProjectNumber int
+	Options       []string
+}
+
+func (e *StatusOptionNotFoundError) Error() string {
+	return fmt.Sprintf(
+		"status %q not found in project %d, available options are: %s",
+		e.Status,
+		e.ProjectNumber,
+		strings.Join(e.Options, ", "),
+	)
+}
+
+func (e *StatusOptionNotFoundError) Unwrap() error {
+	return ErrStatusOptionNotFound
+}
+
+// statusCodeRegex matches the error the GraphQL library returns for responses which aren't 200 OK.
+var statusCodeRegex = regexp.MustCompile(`non-200 OK status code: (\d{3})`)
+
+// The GraphQL library doesn't expose the type of the errors GitHub returns, so they are
+// classified by the HTTP status code and the messages.
+var (
+	rateLimitMessages = []string{"rate limit", "abuse detection"}
+	forbiddenMessages = []string{
+		"Resource not accessible",
+		"has not been granted the required scopes",
+		"does not have permission",
+		"must have admin rights",
+		"Bad credentials",
+	}
+	notFoundMessages = []string{"Could not resolve to"}
+	// staleIDMessages are returned for node IDs which don't exist anymore, like a deleted label, and for
+	// options which have been removed from a single select field.
+	staleIDMessages = []string{
+		"Could not resolve to a node with the global id of",
+		"The single select option Id does not belong to the field",
+	}
+)
+
+// classify wraps the error into an *Error if it's one of the known kinds.
+func classify(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var classified *Error
+	if errors.As(err, &classified) || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return err
+	}
+
+	message := err.Error()
+
+	var status int
+	if match := statusCodeRegex.FindStringSubmatch(message); match != nil {
+		status, _ = strconv.Atoi(match[1])
+	}
+
+	var kind error
+
+	switch {
+	case status == 429 || containsAny(message, rateLimitMessages):
+		kind = ErrRateLimited
+	case status == 401 || status == 403 || containsAny(message, forbiddenMessages):
+		kind = ErrForbidden
+	case strings.Contains(message, "Could not resolve to a ProjectV2"):
+		kind = ErrProjectNotFound
+	case status == 404 || containsAny(message, notFoundMessages):
+		kind = ErrNotFound
+	default:
+		return err
+	}
+
+	return &Error{Kind: kind, Err: err}
+}
+
+func containsAny(message string, substrings []string) bool {
+	for _, s := range substrings {
+		if strings.Contains(message, s) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// classifyingClient classifies the errors of the wrapped client.
+type classifyingClient struct {
+	client GraphQLClient
+}
+
+func (c *classifyingClient) Query(ctx context.Context, q any, variables map[string]any) error {
+	return classify(c.client.Query(ctx, q, variables))
+}
+
+func (c *classifyingClient) Mutate(ctx context.Context, m any, input githubv4.Input, variables map[string]any) error {
+	return classify(c.client.Mutate(ctx, m, input, variables))
+}
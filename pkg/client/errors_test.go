@@ -0,0 +1,65 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassify(t *testing.T) {
+	tests := []struct {
+		err  error
+		want error
+	}{
+		{err: errors.New(`non-200 OK status code: 401 Unauthorized body: "Bad credentials"`), want: ErrForbidden},
+		{err: errors.New("Resource not accessible by integration"), want: ErrForbidden},
+		{err: errors.New("Your token has not been granted the required scopes to execute this query."), want: ErrForbidden},
+		{err: errors.New(`non-200 OK status code: 403 Forbidden body: "You have exceeded a secondary rate limit"`), want: ErrRateLimited},
+		{err: errors.New("API rate limit exceeded for installation ID 1."), want: ErrRateLimited},
+		{err: errors.New("Could not resolve to an Issue with the number of 42."), want: ErrNotFound},
+		{err: errors.New("Could not resolve to a ProjectV2 with the number 3."), want: ErrProjectNotFound},
+		{err: errors.New(`non-200 OK status code: 502 Bad Gateway body: ""`), want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.err.Error(), func(t *testing.T) {
+			err := fmt.Errorf("failed to update: %w", classify(tt.err))
+
+			if tt.want == nil {
+				var classified *Error
+				assert.False(t, errors.As(err, &classified))
+
+				return
+			}
+
+			assert.ErrorIs(t, err, tt.want)
+			assert.ErrorIs(t, err, tt.err)
+			assert.Equal(t, "failed to update: "+tt.err.Error(), err.Error())
+		})
+	}
+
+	assert.ErrorIs(t, ErrProjectNotFound, ErrNotFound)
+	assert.ErrorIs(t, &StatusOptionNotFoundError{Status: "Done"}, ErrNotFound)
+	assert.Equal(t, context.Canceled, classify(context.Canceled))
+}
+
+func TestIsStaleIDError(t *testing.T) {
+	tests := []struct {
+		err  error
+		want bool
+	}{
+		{err: errors.New("Could not resolve to a node with the global id of 'LA_1'"), want: true},
+		{err: errors.New("The single select option Id does not belong to the field"), want: true},
+		{err: errors.New("Could not resolve to an Issue with the number of 42."), want: false},
+		{err: errors.New("The repository does not exist or is archived"), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.err.Error(), func(t *testing.T) {
+			assert.Equal(t, tt.want, isStaleIDError(tt.err))
+		})
+	}
+}
@@ -23,6 +23,21 @@ type FakeClient struct {
 	addLabelReturnsOnCall map[int]struct {
 		result1 error
 	}
+	AddProjectItemStub        func(context.Context, githubv4.String, githubv4.ID) (client.ProjectV2Item, error)
+	addProjectItemMutex       sync.RWMutex
+	addProjectItemArgsForCall []struct {
+		arg1 context.Context
+		arg2 githubv4.String
+		arg3 githubv4.ID
+	}
+	addProjectItemReturns struct {
+		result1 client.ProjectV2Item
+		result2 error
+	}
+	addProjectItemReturnsOnCall map[int]struct {
+		result1 client.ProjectV2Item
+		result2 error
+	}
 	AddReactionStub        func(context.Context, githubv4.ID, githubv4.ReactionContent) error
 	addReactionMutex       sync.RWMutex
 	addReactionArgsForCall []struct {
@@ -36,17 +51,17 @@ type FakeClient struct {
 	addReactionReturnsOnCall map[int]struct {
 		result1 error
 	}
-	AssignIssueToProjectStub        func(context.Context, int, int) error
-	assignIssueToProjectMutex       sync.RWMutex
-	assignIssueToProjectArgsForCall []struct {
+	ArchiveProjectItemStub        func(context.Context, githubv4.ID, githubv4.ID) error
+	archiveProjectItemMutex       sync.RWMutex
+	archiveProjectItemArgsForCall []struct {
 		arg1 context.Context
-		arg2 int
-		arg3 int
+		arg2 githubv4.ID
+		arg3 githubv4.ID
 	}
-	assignIssueToProjectReturns struct {
+	archiveProjectItemReturns struct {
 		result1 error
 	}
-	assignIssueToProjectReturnsOnCall map[int]struct {
+	archiveProjectItemReturnsOnCall map[int]struct {
 		result1 error
 	}
 	AssignUserToAssignableStub        func(context.Context, githubv4.ID, githubv4.ID) error
@@ -62,6 +77,61 @@ type FakeClient struct {
 	assignUserToAssignableReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ClearIssueStatusStub        func(context.Context, client.GenericIssue, int) error
+	clearIssueStatusMutex       sync.RWMutex
+	clearIssueStatusArgsForCall []struct {
+		arg1 context.Context
+		arg2 client.GenericIssue
+		arg3 int
+	}
+	clearIssueStatusReturns struct {
+		result1 error
+	}
+	clearIssueStatusReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CommentsStub        func(context.Context, int) ([]client.Comment, error)
+	commentsMutex       sync.RWMutex
+	commentsArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+	}
+	commentsReturns struct {
+		result1 []client.Comment
+		result2 error
+	}
+	commentsReturnsOnCall map[int]struct {
+		result1 []client.Comment
+		result2 error
+	}
+	ConvertDraftIssueStub        func(context.Context, githubv4.ID, string) (client.Issue, error)
+	convertDraftIssueMutex       sync.RWMutex
+	convertDraftIssueArgsForCall []struct {
+		arg1 context.Context
+		arg2 githubv4.ID
+		arg3 string
+	}
+	convertDraftIssueReturns struct {
+		result1 client.Issue
+		result2 error
+	}
+	convertDraftIssueReturnsOnCall map[int]struct {
+		result1 client.Issue
+		result2 error
+	}
+	DeleteProjectItemStub        func(context.Context, githubv4.ID, githubv4.ID) error
+	deleteProjectItemMutex       sync.RWMutex
+	deleteProjectItemArgsForCall []struct {
+		arg1 context.Context
+		arg2 githubv4.ID
+		arg3 githubv4.ID
+	}
+	deleteProjectItemReturns struct {
+		result1 error
+	}
+	deleteProjectItemReturnsOnCall map[int]struct {
+		result1 error
+	}
 	IssueStub        func(context.Context, int) (client.Issue, error)
 	issueMutex       sync.RWMutex
 	issueArgsForCall []struct {
@@ -89,11 +159,26 @@ type FakeClient struct {
 	leaveCommentReturnsOnCall map[int]struct {
 		result1 error
 	}
-	ProjectItemsStub        func(context.Context, int) ([]client.ProjectV2ItemWithIssueContent, error)
+	ProjectStub        func(context.Context, int) (client.ProjectV2, error)
+	projectMutex       sync.RWMutex
+	projectArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+	}
+	projectReturns struct {
+		result1 client.ProjectV2
+		result2 error
+	}
+	projectReturnsOnCall map[int]struct {
+		result1 client.ProjectV2
+		result2 error
+	}
+	ProjectItemsStub        func(context.Context, int, string) ([]client.ProjectV2ItemWithIssueContent, error)
 	projectItemsMutex       sync.RWMutex
 	projectItemsArgsForCall []struct {
 		arg1 context.Context
 		arg2 int
+		arg3 string
 	}
 	projectItemsReturns struct {
 		result1 []client.ProjectV2ItemWithIssueContent
@@ -143,7 +228,131 @@ type FakeClient struct {
 	removeLabelReturnsOnCall map[int]struct {
 		result1 error
 	}
-	UpdateIssueStatusStub        func(context.Context, client.GenericIssue, githubv4.String, int) (bool, error)
+	RepositoriesStub        func(context.Context) ([]string, error)
+	repositoriesMutex       sync.RWMutex
+	repositoriesArgsForCall []struct {
+		arg1 context.Context
+	}
+	repositoriesReturns struct {
+		result1 []string
+		result2 error
+	}
+	repositoriesReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
+	RepositoryPermissionStub        func(context.Context, string) (githubv4.RepositoryPermission, error)
+	repositoryPermissionMutex       sync.RWMutex
+	repositoryPermissionArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	repositoryPermissionReturns struct {
+		result1 githubv4.RepositoryPermission
+		result2 error
+	}
+	repositoryPermissionReturnsOnCall map[int]struct {
+		result1 githubv4.RepositoryPermission
+		result2 error
+	}
+	RequestReviewsStub        func(context.Context, githubv4.ID, []githubv4.ID, []githubv4.ID) error
+	requestReviewsMutex       sync.RWMutex
+	requestReviewsArgsForCall []struct {
+		arg1 context.Context
+		arg2 githubv4.ID
+		arg3 []githubv4.ID
+		arg4 []githubv4.ID
+	}
+	requestReviewsReturns struct {
+		result1 error
+	}
+	requestReviewsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SearchRepositoriesStub        func(context.Context, string) ([]string, error)
+	searchRepositoriesMutex       sync.RWMutex
+	searchRepositoriesArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	searchRepositoriesReturns struct {
+		result1 []string
+		result2 error
+	}
+	searchRepositoriesReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
+	SetProjectItemFieldStub        func(context.Context, githubv4.String, githubv4.String, string, string) error
+	setProjectItemFieldMutex       sync.RWMutex
+	setProjectItemFieldArgsForCall []struct {
+		arg1 context.Context
+		arg2 githubv4.String
+		arg3 githubv4.String
+		arg4 string
+		arg5 string
+	}
+	setProjectItemFieldReturns struct {
+		result1 error
+	}
+	setProjectItemFieldReturnsOnCall map[int]struct {
+		result1 error
+	}
+	TeamStub        func(context.Context, string, string) (client.Team, error)
+	teamMutex       sync.RWMutex
+	teamArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	teamReturns struct {
+		result1 client.Team
+		result2 error
+	}
+	teamReturnsOnCall map[int]struct {
+		result1 client.Team
+		result2 error
+	}
+	UnarchiveProjectItemStub        func(context.Context, githubv4.ID, githubv4.ID) error
+	unarchiveProjectItemMutex       sync.RWMutex
+	unarchiveProjectItemArgsForCall []struct {
+		arg1 context.Context
+		arg2 githubv4.ID
+		arg3 githubv4.ID
+	}
+	unarchiveProjectItemReturns struct {
+		result1 error
+	}
+	unarchiveProjectItemReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UnassignUserFromAssignableStub        func(context.Context, githubv4.ID, githubv4.ID) error
+	unassignUserFromAssignableMutex       sync.RWMutex
+	unassignUserFromAssignableArgsForCall []struct {
+		arg1 context.Context
+		arg2 githubv4.ID
+		arg3 githubv4.ID
+	}
+	unassignUserFromAssignableReturns struct {
+		result1 error
+	}
+	unassignUserFromAssignableReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateCommentStub        func(context.Context, githubv4.ID, string) error
+	updateCommentMutex       sync.RWMutex
+	updateCommentArgsForCall []struct {
+		arg1 context.Context
+		arg2 githubv4.ID
+		arg3 string
+	}
+	updateCommentReturns struct {
+		result1 error
+	}
+	updateCommentReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateIssueStatusStub        func(context.Context, client.GenericIssue, githubv4.String, int) (client.StatusResult, error)
 	updateIssueStatusMutex       sync.RWMutex
 	updateIssueStatusArgsForCall []struct {
 		arg1 context.Context
@@ -152,13 +361,26 @@ type FakeClient struct {
 		arg4 int
 	}
 	updateIssueStatusReturns struct {
-		result1 bool
+		result1 client.StatusResult
 		result2 error
 	}
 	updateIssueStatusReturnsOnCall map[int]struct {
-		result1 bool
+		result1 client.StatusResult
 		result2 error
 	}
+	UpdatePullRequestBodyStub        func(context.Context, githubv4.ID, string) error
+	updatePullRequestBodyMutex       sync.RWMutex
+	updatePullRequestBodyArgsForCall []struct {
+		arg1 context.Context
+		arg2 githubv4.ID
+		arg3 string
+	}
+	updatePullRequestBodyReturns struct {
+		result1 error
+	}
+	updatePullRequestBodyReturnsOnCall map[int]struct {
+		result1 error
+	}
 	UserStub        func(context.Context, string) (client.User, error)
 	userMutex       sync.RWMutex
 	userArgsForCall []struct {
@@ -173,6 +395,34 @@ type FakeClient struct {
 		result1 client.User
 		result2 error
 	}
+	ViewerHasReactedStub        func(context.Context, githubv4.ID, githubv4.ReactionContent) (bool, error)
+	viewerHasReactedMutex       sync.RWMutex
+	viewerHasReactedArgsForCall []struct {
+		arg1 context.Context
+		arg2 githubv4.ID
+		arg3 githubv4.ReactionContent
+	}
+	viewerHasReactedReturns struct {
+		result1 bool
+		result2 error
+	}
+	viewerHasReactedReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	ViewerLoginStub        func(context.Context) (string, error)
+	viewerLoginMutex       sync.RWMutex
+	viewerLoginArgsForCall []struct {
+		arg1 context.Context
+	}
+	viewerLoginReturns struct {
+		result1 string
+		result2 error
+	}
+	viewerLoginReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -240,6 +490,72 @@ func (fake *FakeClient) AddLabelReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeClient) AddProjectItem(arg1 context.Context, arg2 githubv4.String, arg3 githubv4.ID) (client.ProjectV2Item, error) {
+	fake.addProjectItemMutex.Lock()
+	ret, specificReturn := fake.addProjectItemReturnsOnCall[len(fake.addProjectItemArgsForCall)]
+	fake.addProjectItemArgsForCall = append(fake.addProjectItemArgsForCall, struct {
+		arg1 context.Context
+		arg2 githubv4.String
+		arg3 githubv4.ID
+	}{arg1, arg2, arg3})
+	stub := fake.AddProjectItemStub
+	fakeReturns := fake.addProjectItemReturns
+	fake.recordInvocation("AddProjectItem", []interface{}{arg1, arg2, arg3})
+	fake.addProjectItemMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) AddProjectItemCallCount() int {
+	fake.addProjectItemMutex.RLock()
+	defer fake.addProjectItemMutex.RUnlock()
+	return len(fake.addProjectItemArgsForCall)
+}
+
+func (fake *FakeClient) AddProjectItemCalls(stub func(context.Context, githubv4.String, githubv4.ID) (client.ProjectV2Item, error)) {
+	fake.addProjectItemMutex.Lock()
+	defer fake.addProjectItemMutex.Unlock()
+	fake.AddProjectItemStub = stub
+}
+
+func (fake *FakeClient) AddProjectItemArgsForCall(i int) (context.Context, githubv4.String, githubv4.ID) {
+	fake.addProjectItemMutex.RLock()
+	defer fake.addProjectItemMutex.RUnlock()
+	argsForCall := fake.addProjectItemArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) AddProjectItemReturns(result1 client.ProjectV2Item, result2 error) {
+	fake.addProjectItemMutex.Lock()
+	defer fake.addProjectItemMutex.Unlock()
+	fake.AddProjectItemStub = nil
+	fake.addProjectItemReturns = struct {
+		result1 client.ProjectV2Item
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) AddProjectItemReturnsOnCall(i int, result1 client.ProjectV2Item, result2 error) {
+	fake.addProjectItemMutex.Lock()
+	defer fake.addProjectItemMutex.Unlock()
+	fake.AddProjectItemStub = nil
+	if fake.addProjectItemReturnsOnCall == nil {
+		fake.addProjectItemReturnsOnCall = make(map[int]struct {
+			result1 client.ProjectV2Item
+			result2 error
+		})
+	}
+	fake.addProjectItemReturnsOnCall[i] = struct {
+		result1 client.ProjectV2Item
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) AddReaction(arg1 context.Context, arg2 githubv4.ID, arg3 githubv4.ReactionContent) error {
 	fake.addReactionMutex.Lock()
 	ret, specificReturn := fake.addReactionReturnsOnCall[len(fake.addReactionArgsForCall)]
@@ -303,18 +619,18 @@ func (fake *FakeClient) AddReactionReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeClient) AssignIssueToProject(arg1 context.Context, arg2 int, arg3 int) error {
-	fake.assignIssueToProjectMutex.Lock()
-	ret, specificReturn := fake.assignIssueToProjectReturnsOnCall[len(fake.assignIssueToProjectArgsForCall)]
-	fake.assignIssueToProjectArgsForCall = append(fake.assignIssueToProjectArgsForCall, struct {
+func (fake *FakeClient) ArchiveProjectItem(arg1 context.Context, arg2 githubv4.ID, arg3 githubv4.ID) error {
+	fake.archiveProjectItemMutex.Lock()
+	ret, specificReturn := fake.archiveProjectItemReturnsOnCall[len(fake.archiveProjectItemArgsForCall)]
+	fake.archiveProjectItemArgsForCall = append(fake.archiveProjectItemArgsForCall, struct {
 		arg1 context.Context
-		arg2 int
-		arg3 int
+		arg2 githubv4.ID
+		arg3 githubv4.ID
 	}{arg1, arg2, arg3})
-	stub := fake.AssignIssueToProjectStub
-	fakeReturns := fake.assignIssueToProjectReturns
-	fake.recordInvocation("AssignIssueToProject", []interface{}{arg1, arg2, arg3})
-	fake.assignIssueToProjectMutex.Unlock()
+	stub := fake.ArchiveProjectItemStub
+	fakeReturns := fake.archiveProjectItemReturns
+	fake.recordInvocation("ArchiveProjectItem", []interface{}{arg1, arg2, arg3})
+	fake.archiveProjectItemMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2, arg3)
 	}
@@ -324,44 +640,44 @@ func (fake *FakeClient) AssignIssueToProject(arg1 context.Context, arg2 int, arg
 	return fakeReturns.result1
 }
 
-func (fake *FakeClient) AssignIssueToProjectCallCount() int {
-	fake.assignIssueToProjectMutex.RLock()
-	defer fake.assignIssueToProjectMutex.RUnlock()
-	return len(fake.assignIssueToProjectArgsForCall)
+func (fake *FakeClient) ArchiveProjectItemCallCount() int {
+	fake.archiveProjectItemMutex.RLock()
+	defer fake.archiveProjectItemMutex.RUnlock()
+	return len(fake.archiveProjectItemArgsForCall)
 }
 
-func (fake *FakeClient) AssignIssueToProjectCalls(stub func(context.Context, int, int) error) {
-	fake.assignIssueToProjectMutex.Lock()
-	defer fake.assignIssueToProjectMutex.Unlock()
-	fake.AssignIssueToProjectStub = stub
+func (fake *FakeClient) ArchiveProjectItemCalls(stub func(context.Context, githubv4.ID, githubv4.ID) error) {
+	fake.archiveProjectItemMutex.Lock()
+	defer fake.archiveProjectItemMutex.Unlock()
+	fake.ArchiveProjectItemStub = stub
 }
 
-func (fake *FakeClient) AssignIssueToProjectArgsForCall(i int) (context.Context, int, int) {
-	fake.assignIssueToProjectMutex.RLock()
-	defer fake.assignIssueToProjectMutex.RUnlock()
-	argsForCall := fake.assignIssueToProjectArgsForCall[i]
+func (fake *FakeClient) ArchiveProjectItemArgsForCall(i int) (context.Context, githubv4.ID, githubv4.ID) {
+	fake.archiveProjectItemMutex.RLock()
+	defer fake.archiveProjectItemMutex.RUnlock()
+	argsForCall := fake.archiveProjectItemArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeClient) AssignIssueToProjectReturns(result1 error) {
-	fake.assignIssueToProjectMutex.Lock()
-	defer fake.assignIssueToProjectMutex.Unlock()
-	fake.AssignIssueToProjectStub = nil
-	fake.assignIssueToProjectReturns = struct {
+func (fake *FakeClient) ArchiveProjectItemReturns(result1 error) {
+	fake.archiveProjectItemMutex.Lock()
+	defer fake.archiveProjectItemMutex.Unlock()
+	fake.ArchiveProjectItemStub = nil
+	fake.archiveProjectItemReturns = struct {
 		result1 error
 	}{result1}
 }
 
-func (fake *FakeClient) AssignIssueToProjectReturnsOnCall(i int, result1 error) {
-	fake.assignIssueToProjectMutex.Lock()
-	defer fake.assignIssueToProjectMutex.Unlock()
-	fake.AssignIssueToProjectStub = nil
-	if fake.assignIssueToProjectReturnsOnCall == nil {
-		fake.assignIssueToProjectReturnsOnCall = make(map[int]struct {
+func (fake *FakeClient) ArchiveProjectItemReturnsOnCall(i int, result1 error) {
+	fake.archiveProjectItemMutex.Lock()
+	defer fake.archiveProjectItemMutex.Unlock()
+	fake.ArchiveProjectItemStub = nil
+	if fake.archiveProjectItemReturnsOnCall == nil {
+		fake.archiveProjectItemReturnsOnCall = make(map[int]struct {
 			result1 error
 		})
 	}
-	fake.assignIssueToProjectReturnsOnCall[i] = struct {
+	fake.archiveProjectItemReturnsOnCall[i] = struct {
 		result1 error
 	}{result1}
 }
@@ -429,17 +745,80 @@ func (fake *FakeClient) AssignUserToAssignableReturnsOnCall(i int, result1 error
 	}{result1}
 }
 
-func (fake *FakeClient) Issue(arg1 context.Context, arg2 int) (client.Issue, error) {
-	fake.issueMutex.Lock()
-	ret, specificReturn := fake.issueReturnsOnCall[len(fake.issueArgsForCall)]
-	fake.issueArgsForCall = append(fake.issueArgsForCall, struct {
+func (fake *FakeClient) ClearIssueStatus(arg1 context.Context, arg2 client.GenericIssue, arg3 int) error {
+	fake.clearIssueStatusMutex.Lock()
+	ret, specificReturn := fake.clearIssueStatusReturnsOnCall[len(fake.clearIssueStatusArgsForCall)]
+	fake.clearIssueStatusArgsForCall = append(fake.clearIssueStatusArgsForCall, struct {
+		arg1 context.Context
+		arg2 client.GenericIssue
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.ClearIssueStatusStub
+	fakeReturns := fake.clearIssueStatusReturns
+	fake.recordInvocation("ClearIssueStatus", []interface{}{arg1, arg2, arg3})
+	fake.clearIssueStatusMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) ClearIssueStatusCallCount() int {
+	fake.clearIssueStatusMutex.RLock()
+	defer fake.clearIssueStatusMutex.RUnlock()
+	return len(fake.clearIssueStatusArgsForCall)
+}
+
+func (fake *FakeClient) ClearIssueStatusCalls(stub func(context.Context, client.GenericIssue, int) error) {
+	fake.clearIssueStatusMutex.Lock()
+	defer fake.clearIssueStatusMutex.Unlock()
+	fake.ClearIssueStatusStub = stub
+}
+
+func (fake *FakeClient) ClearIssueStatusArgsForCall(i int) (context.Context, client.GenericIssue, int) {
+	fake.clearIssueStatusMutex.RLock()
+	defer fake.clearIssueStatusMutex.RUnlock()
+	argsForCall := fake.clearIssueStatusArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) ClearIssueStatusReturns(result1 error) {
+	fake.clearIssueStatusMutex.Lock()
+	defer fake.clearIssueStatusMutex.Unlock()
+	fake.ClearIssueStatusStub = nil
+	fake.clearIssueStatusReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) ClearIssueStatusReturnsOnCall(i int, result1 error) {
+	fake.clearIssueStatusMutex.Lock()
+	defer fake.clearIssueStatusMutex.Unlock()
+	fake.ClearIssueStatusStub = nil
+	if fake.clearIssueStatusReturnsOnCall == nil {
+		fake.clearIssueStatusReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.clearIssueStatusReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) Comments(arg1 context.Context, arg2 int) ([]client.Comment, error) {
+	fake.commentsMutex.Lock()
+	ret, specificReturn := fake.commentsReturnsOnCall[len(fake.commentsArgsForCall)]
+	fake.commentsArgsForCall = append(fake.commentsArgsForCall, struct {
 		arg1 context.Context
 		arg2 int
 	}{arg1, arg2})
-	stub := fake.IssueStub
-	fakeReturns := fake.issueReturns
-	fake.recordInvocation("Issue", []interface{}{arg1, arg2})
-	fake.issueMutex.Unlock()
+	stub := fake.CommentsStub
+	fakeReturns := fake.commentsReturns
+	fake.recordInvocation("Comments", []interface{}{arg1, arg2})
+	fake.commentsMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2)
 	}
@@ -449,70 +828,264 @@ func (fake *FakeClient) Issue(arg1 context.Context, arg2 int) (client.Issue, err
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeClient) IssueCallCount() int {
-	fake.issueMutex.RLock()
-	defer fake.issueMutex.RUnlock()
-	return len(fake.issueArgsForCall)
+func (fake *FakeClient) CommentsCallCount() int {
+	fake.commentsMutex.RLock()
+	defer fake.commentsMutex.RUnlock()
+	return len(fake.commentsArgsForCall)
 }
 
-func (fake *FakeClient) IssueCalls(stub func(context.Context, int) (client.Issue, error)) {
-	fake.issueMutex.Lock()
-	defer fake.issueMutex.Unlock()
-	fake.IssueStub = stub
+func (fake *FakeClient) CommentsCalls(stub func(context.Context, int) ([]client.Comment, error)) {
+	fake.commentsMutex.Lock()
+	defer fake.commentsMutex.Unlock()
+	fake.CommentsStub = stub
 }
 
-func (fake *FakeClient) IssueArgsForCall(i int) (context.Context, int) {
-	fake.issueMutex.RLock()
-	defer fake.issueMutex.RUnlock()
-	argsForCall := fake.issueArgsForCall[i]
+func (fake *FakeClient) CommentsArgsForCall(i int) (context.Context, int) {
+	fake.commentsMutex.RLock()
+	defer fake.commentsMutex.RUnlock()
+	argsForCall := fake.commentsArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeClient) IssueReturns(result1 client.Issue, result2 error) {
-	fake.issueMutex.Lock()
-	defer fake.issueMutex.Unlock()
-	fake.IssueStub = nil
-	fake.issueReturns = struct {
-		result1 client.Issue
+func (fake *FakeClient) CommentsReturns(result1 []client.Comment, result2 error) {
+	fake.commentsMutex.Lock()
+	defer fake.commentsMutex.Unlock()
+	fake.CommentsStub = nil
+	fake.commentsReturns = struct {
+		result1 []client.Comment
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeClient) IssueReturnsOnCall(i int, result1 client.Issue, result2 error) {
-	fake.issueMutex.Lock()
-	defer fake.issueMutex.Unlock()
-	fake.IssueStub = nil
-	if fake.issueReturnsOnCall == nil {
-		fake.issueReturnsOnCall = make(map[int]struct {
-			result1 client.Issue
+func (fake *FakeClient) CommentsReturnsOnCall(i int, result1 []client.Comment, result2 error) {
+	fake.commentsMutex.Lock()
+	defer fake.commentsMutex.Unlock()
+	fake.CommentsStub = nil
+	if fake.commentsReturnsOnCall == nil {
+		fake.commentsReturnsOnCall = make(map[int]struct {
+			result1 []client.Comment
 			result2 error
 		})
 	}
-	fake.issueReturnsOnCall[i] = struct {
-		result1 client.Issue
+	fake.commentsReturnsOnCall[i] = struct {
+		result1 []client.Comment
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeClient) LeaveComment(arg1 context.Context, arg2 githubv4.ID, arg3 string) error {
-	fake.leaveCommentMutex.Lock()
-	ret, specificReturn := fake.leaveCommentReturnsOnCall[len(fake.leaveCommentArgsForCall)]
-	fake.leaveCommentArgsForCall = append(fake.leaveCommentArgsForCall, struct {
+func (fake *FakeClient) ConvertDraftIssue(arg1 context.Context, arg2 githubv4.ID, arg3 string) (client.Issue, error) {
+	fake.convertDraftIssueMutex.Lock()
+	ret, specificReturn := fake.convertDraftIssueReturnsOnCall[len(fake.convertDraftIssueArgsForCall)]
+	fake.convertDraftIssueArgsForCall = append(fake.convertDraftIssueArgsForCall, struct {
 		arg1 context.Context
 		arg2 githubv4.ID
 		arg3 string
 	}{arg1, arg2, arg3})
-	stub := fake.LeaveCommentStub
-	fakeReturns := fake.leaveCommentReturns
-	fake.recordInvocation("LeaveComment", []interface{}{arg1, arg2, arg3})
-	fake.leaveCommentMutex.Unlock()
+	stub := fake.ConvertDraftIssueStub
+	fakeReturns := fake.convertDraftIssueReturns
+	fake.recordInvocation("ConvertDraftIssue", []interface{}{arg1, arg2, arg3})
+	fake.convertDraftIssueMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
-		return ret.result1
+		return ret.result1, ret.result2
 	}
-	return fakeReturns.result1
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) ConvertDraftIssueCallCount() int {
+	fake.convertDraftIssueMutex.RLock()
+	defer fake.convertDraftIssueMutex.RUnlock()
+	return len(fake.convertDraftIssueArgsForCall)
+}
+
+func (fake *FakeClient) ConvertDraftIssueCalls(stub func(context.Context, githubv4.ID, string) (client.Issue, error)) {
+	fake.convertDraftIssueMutex.Lock()
+	defer fake.convertDraftIssueMutex.Unlock()
+	fake.ConvertDraftIssueStub = stub
+}
+
+func (fake *FakeClient) ConvertDraftIssueArgsForCall(i int) (context.Context, githubv4.ID, string) {
+	fake.convertDraftIssueMutex.RLock()
+	defer fake.convertDraftIssueMutex.RUnlock()
+	argsForCall := fake.convertDraftIssueArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) ConvertDraftIssueReturns(result1 client.Issue, result2 error) {
+	fake.convertDraftIssueMutex.Lock()
+	defer fake.convertDraftIssueMutex.Unlock()
+	fake.ConvertDraftIssueStub = nil
+	fake.convertDraftIssueReturns = struct {
+		result1 client.Issue
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ConvertDraftIssueReturnsOnCall(i int, result1 client.Issue, result2 error) {
+	fake.convertDraftIssueMutex.Lock()
+	defer fake.convertDraftIssueMutex.Unlock()
+	fake.ConvertDraftIssueStub = nil
+	if fake.convertDraftIssueReturnsOnCall == nil {
+		fake.convertDraftIssueReturnsOnCall = make(map[int]struct {
+			result1 client.Issue
+			result2 error
+		})
+	}
+	fake.convertDraftIssueReturnsOnCall[i] = struct {
+		result1 client.Issue
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) DeleteProjectItem(arg1 context.Context, arg2 githubv4.ID, arg3 githubv4.ID) error {
+	fake.deleteProjectItemMutex.Lock()
+	ret, specificReturn := fake.deleteProjectItemReturnsOnCall[len(fake.deleteProjectItemArgsForCall)]
+	fake.deleteProjectItemArgsForCall = append(fake.deleteProjectItemArgsForCall, struct {
+		arg1 context.Context
+		arg2 githubv4.ID
+		arg3 githubv4.ID
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteProjectItemStub
+	fakeReturns := fake.deleteProjectItemReturns
+	fake.recordInvocation("DeleteProjectItem", []interface{}{arg1, arg2, arg3})
+	fake.deleteProjectItemMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) DeleteProjectItemCallCount() int {
+	fake.deleteProjectItemMutex.RLock()
+	defer fake.deleteProjectItemMutex.RUnlock()
+	return len(fake.deleteProjectItemArgsForCall)
+}
+
+func (fake *FakeClient) DeleteProjectItemCalls(stub func(context.Context, githubv4.ID, githubv4.ID) error) {
+	fake.deleteProjectItemMutex.Lock()
+	defer fake.deleteProjectItemMutex.Unlock()
+	fake.DeleteProjectItemStub = stub
+}
+
+func (fake *FakeClient) DeleteProjectItemArgsForCall(i int) (context.Context, githubv4.ID, githubv4.ID) {
+	fake.deleteProjectItemMutex.RLock()
+	defer fake.deleteProjectItemMutex.RUnlock()
+	argsForCall := fake.deleteProjectItemArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) DeleteProjectItemReturns(result1 error) {
+	fake.deleteProjectItemMutex.Lock()
+	defer fake.deleteProjectItemMutex.Unlock()
+	fake.DeleteProjectItemStub = nil
+	fake.deleteProjectItemReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) DeleteProjectItemReturnsOnCall(i int, result1 error) {
+	fake.deleteProjectItemMutex.Lock()
+	defer fake.deleteProjectItemMutex.Unlock()
+	fake.DeleteProjectItemStub = nil
+	if fake.deleteProjectItemReturnsOnCall == nil {
+		fake.deleteProjectItemReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteProjectItemReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) Issue(arg1 context.Context, arg2 int) (client.Issue, error) {
+	fake.issueMutex.Lock()
+	ret, specificReturn := fake.issueReturnsOnCall[len(fake.issueArgsForCall)]
+	fake.issueArgsForCall = append(fake.issueArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.IssueStub
+	fakeReturns := fake.issueReturns
+	fake.recordInvocation("Issue", []interface{}{arg1, arg2})
+	fake.issueMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) IssueCallCount() int {
+	fake.issueMutex.RLock()
+	defer fake.issueMutex.RUnlock()
+	return len(fake.issueArgsForCall)
+}
+
+func (fake *FakeClient) IssueCalls(stub func(context.Context, int) (client.Issue, error)) {
+	fake.issueMutex.Lock()
+	defer fake.issueMutex.Unlock()
+	fake.IssueStub = stub
+}
+
+func (fake *FakeClient) IssueArgsForCall(i int) (context.Context, int) {
+	fake.issueMutex.RLock()
+	defer fake.issueMutex.RUnlock()
+	argsForCall := fake.issueArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) IssueReturns(result1 client.Issue, result2 error) {
+	fake.issueMutex.Lock()
+	defer fake.issueMutex.Unlock()
+	fake.IssueStub = nil
+	fake.issueReturns = struct {
+		result1 client.Issue
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) IssueReturnsOnCall(i int, result1 client.Issue, result2 error) {
+	fake.issueMutex.Lock()
+	defer fake.issueMutex.Unlock()
+	fake.IssueStub = nil
+	if fake.issueReturnsOnCall == nil {
+		fake.issueReturnsOnCall = make(map[int]struct {
+			result1 client.Issue
+			result2 error
+		})
+	}
+	fake.issueReturnsOnCall[i] = struct {
+		result1 client.Issue
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) LeaveComment(arg1 context.Context, arg2 githubv4.ID, arg3 string) error {
+	fake.leaveCommentMutex.Lock()
+	ret, specificReturn := fake.leaveCommentReturnsOnCall[len(fake.leaveCommentArgsForCall)]
+	fake.leaveCommentArgsForCall = append(fake.leaveCommentArgsForCall, struct {
+		arg1 context.Context
+		arg2 githubv4.ID
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.LeaveCommentStub
+	fakeReturns := fake.leaveCommentReturns
+	fake.recordInvocation("LeaveComment", []interface{}{arg1, arg2, arg3})
+	fake.leaveCommentMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
 }
 
 func (fake *FakeClient) LeaveCommentCallCount() int {
@@ -557,19 +1130,85 @@ func (fake *FakeClient) LeaveCommentReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeClient) ProjectItems(arg1 context.Context, arg2 int) ([]client.ProjectV2ItemWithIssueContent, error) {
+func (fake *FakeClient) Project(arg1 context.Context, arg2 int) (client.ProjectV2, error) {
+	fake.projectMutex.Lock()
+	ret, specificReturn := fake.projectReturnsOnCall[len(fake.projectArgsForCall)]
+	fake.projectArgsForCall = append(fake.projectArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.ProjectStub
+	fakeReturns := fake.projectReturns
+	fake.recordInvocation("Project", []interface{}{arg1, arg2})
+	fake.projectMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) ProjectCallCount() int {
+	fake.projectMutex.RLock()
+	defer fake.projectMutex.RUnlock()
+	return len(fake.projectArgsForCall)
+}
+
+func (fake *FakeClient) ProjectCalls(stub func(context.Context, int) (client.ProjectV2, error)) {
+	fake.projectMutex.Lock()
+	defer fake.projectMutex.Unlock()
+	fake.ProjectStub = stub
+}
+
+func (fake *FakeClient) ProjectArgsForCall(i int) (context.Context, int) {
+	fake.projectMutex.RLock()
+	defer fake.projectMutex.RUnlock()
+	argsForCall := fake.projectArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) ProjectReturns(result1 client.ProjectV2, result2 error) {
+	fake.projectMutex.Lock()
+	defer fake.projectMutex.Unlock()
+	fake.ProjectStub = nil
+	fake.projectReturns = struct {
+		result1 client.ProjectV2
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ProjectReturnsOnCall(i int, result1 client.ProjectV2, result2 error) {
+	fake.projectMutex.Lock()
+	defer fake.projectMutex.Unlock()
+	fake.ProjectStub = nil
+	if fake.projectReturnsOnCall == nil {
+		fake.projectReturnsOnCall = make(map[int]struct {
+			result1 client.ProjectV2
+			result2 error
+		})
+	}
+	fake.projectReturnsOnCall[i] = struct {
+		result1 client.ProjectV2
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ProjectItems(arg1 context.Context, arg2 int, arg3 string) ([]client.ProjectV2ItemWithIssueContent, error) {
 	fake.projectItemsMutex.Lock()
 	ret, specificReturn := fake.projectItemsReturnsOnCall[len(fake.projectItemsArgsForCall)]
 	fake.projectItemsArgsForCall = append(fake.projectItemsArgsForCall, struct {
 		arg1 context.Context
 		arg2 int
-	}{arg1, arg2})
+		arg3 string
+	}{arg1, arg2, arg3})
 	stub := fake.ProjectItemsStub
 	fakeReturns := fake.projectItemsReturns
-	fake.recordInvocation("ProjectItems", []interface{}{arg1, arg2})
+	fake.recordInvocation("ProjectItems", []interface{}{arg1, arg2, arg3})
 	fake.projectItemsMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -583,17 +1222,17 @@ func (fake *FakeClient) ProjectItemsCallCount() int {
 	return len(fake.projectItemsArgsForCall)
 }
 
-func (fake *FakeClient) ProjectItemsCalls(stub func(context.Context, int) ([]client.ProjectV2ItemWithIssueContent, error)) {
+func (fake *FakeClient) ProjectItemsCalls(stub func(context.Context, int, string) ([]client.ProjectV2ItemWithIssueContent, error)) {
 	fake.projectItemsMutex.Lock()
 	defer fake.projectItemsMutex.Unlock()
 	fake.ProjectItemsStub = stub
 }
 
-func (fake *FakeClient) ProjectItemsArgsForCall(i int) (context.Context, int) {
+func (fake *FakeClient) ProjectItemsArgsForCall(i int) (context.Context, int, string) {
 	fake.projectItemsMutex.RLock()
 	defer fake.projectItemsMutex.RUnlock()
 	argsForCall := fake.projectItemsArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *FakeClient) ProjectItemsReturns(result1 []client.ProjectV2ItemWithIssueContent, result2 error) {
@@ -814,21 +1453,18 @@ func (fake *FakeClient) RemoveLabelReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeClient) UpdateIssueStatus(arg1 context.Context, arg2 client.GenericIssue, arg3 githubv4.String, arg4 int) (bool, error) {
-	fake.updateIssueStatusMutex.Lock()
-	ret, specificReturn := fake.updateIssueStatusReturnsOnCall[len(fake.updateIssueStatusArgsForCall)]
-	fake.updateIssueStatusArgsForCall = append(fake.updateIssueStatusArgsForCall, struct {
+func (fake *FakeClient) Repositories(arg1 context.Context) ([]string, error) {
+	fake.repositoriesMutex.Lock()
+	ret, specificReturn := fake.repositoriesReturnsOnCall[len(fake.repositoriesArgsForCall)]
+	fake.repositoriesArgsForCall = append(fake.repositoriesArgsForCall, struct {
 		arg1 context.Context
-		arg2 client.GenericIssue
-		arg3 githubv4.String
-		arg4 int
-	}{arg1, arg2, arg3, arg4})
-	stub := fake.UpdateIssueStatusStub
-	fakeReturns := fake.updateIssueStatusReturns
-	fake.recordInvocation("UpdateIssueStatus", []interface{}{arg1, arg2, arg3, arg4})
-	fake.updateIssueStatusMutex.Unlock()
+	}{arg1})
+	stub := fake.RepositoriesStub
+	fakeReturns := fake.repositoriesReturns
+	fake.recordInvocation("Repositories", []interface{}{arg1})
+	fake.repositoriesMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4)
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -836,62 +1472,62 @@ func (fake *FakeClient) UpdateIssueStatus(arg1 context.Context, arg2 client.Gene
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeClient) UpdateIssueStatusCallCount() int {
-	fake.updateIssueStatusMutex.RLock()
-	defer fake.updateIssueStatusMutex.RUnlock()
-	return len(fake.updateIssueStatusArgsForCall)
+func (fake *FakeClient) RepositoriesCallCount() int {
+	fake.repositoriesMutex.RLock()
+	defer fake.repositoriesMutex.RUnlock()
+	return len(fake.repositoriesArgsForCall)
 }
 
-func (fake *FakeClient) UpdateIssueStatusCalls(stub func(context.Context, client.GenericIssue, githubv4.String, int) (bool, error)) {
-	fake.updateIssueStatusMutex.Lock()
-	defer fake.updateIssueStatusMutex.Unlock()
-	fake.UpdateIssueStatusStub = stub
+func (fake *FakeClient) RepositoriesCalls(stub func(context.Context) ([]string, error)) {
+	fake.repositoriesMutex.Lock()
+	defer fake.repositoriesMutex.Unlock()
+	fake.RepositoriesStub = stub
 }
 
-func (fake *FakeClient) UpdateIssueStatusArgsForCall(i int) (context.Context, client.GenericIssue, githubv4.String, int) {
-	fake.updateIssueStatusMutex.RLock()
-	defer fake.updateIssueStatusMutex.RUnlock()
-	argsForCall := fake.updateIssueStatusArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+func (fake *FakeClient) RepositoriesArgsForCall(i int) context.Context {
+	fake.repositoriesMutex.RLock()
+	defer fake.repositoriesMutex.RUnlock()
+	argsForCall := fake.repositoriesArgsForCall[i]
+	return argsForCall.arg1
 }
 
-func (fake *FakeClient) UpdateIssueStatusReturns(result1 bool, result2 error) {
-	fake.updateIssueStatusMutex.Lock()
-	defer fake.updateIssueStatusMutex.Unlock()
-	fake.UpdateIssueStatusStub = nil
-	fake.updateIssueStatusReturns = struct {
-		result1 bool
+func (fake *FakeClient) RepositoriesReturns(result1 []string, result2 error) {
+	fake.repositoriesMutex.Lock()
+	defer fake.repositoriesMutex.Unlock()
+	fake.RepositoriesStub = nil
+	fake.repositoriesReturns = struct {
+		result1 []string
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeClient) UpdateIssueStatusReturnsOnCall(i int, result1 bool, result2 error) {
-	fake.updateIssueStatusMutex.Lock()
-	defer fake.updateIssueStatusMutex.Unlock()
-	fake.UpdateIssueStatusStub = nil
-	if fake.updateIssueStatusReturnsOnCall == nil {
-		fake.updateIssueStatusReturnsOnCall = make(map[int]struct {
-			result1 bool
+func (fake *FakeClient) RepositoriesReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.repositoriesMutex.Lock()
+	defer fake.repositoriesMutex.Unlock()
+	fake.RepositoriesStub = nil
+	if fake.repositoriesReturnsOnCall == nil {
+		fake.repositoriesReturnsOnCall = make(map[int]struct {
+			result1 []string
 			result2 error
 		})
 	}
-	fake.updateIssueStatusReturnsOnCall[i] = struct {
-		result1 bool
+	fake.repositoriesReturnsOnCall[i] = struct {
+		result1 []string
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeClient) User(arg1 context.Context, arg2 string) (client.User, error) {
-	fake.userMutex.Lock()
-	ret, specificReturn := fake.userReturnsOnCall[len(fake.userArgsForCall)]
-	fake.userArgsForCall = append(fake.userArgsForCall, struct {
+func (fake *FakeClient) RepositoryPermission(arg1 context.Context, arg2 string) (githubv4.RepositoryPermission, error) {
+	fake.repositoryPermissionMutex.Lock()
+	ret, specificReturn := fake.repositoryPermissionReturnsOnCall[len(fake.repositoryPermissionArgsForCall)]
+	fake.repositoryPermissionArgsForCall = append(fake.repositoryPermissionArgsForCall, struct {
 		arg1 context.Context
 		arg2 string
 	}{arg1, arg2})
-	stub := fake.UserStub
-	fakeReturns := fake.userReturns
-	fake.recordInvocation("User", []interface{}{arg1, arg2})
-	fake.userMutex.Unlock()
+	stub := fake.RepositoryPermissionStub
+	fakeReturns := fake.repositoryPermissionReturns
+	fake.recordInvocation("RepositoryPermission", []interface{}{arg1, arg2})
+	fake.repositoryPermissionMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2)
 	}
@@ -901,47 +1537,831 @@ func (fake *FakeClient) User(arg1 context.Context, arg2 string) (client.User, er
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeClient) UserCallCount() int {
-	fake.userMutex.RLock()
-	defer fake.userMutex.RUnlock()
-	return len(fake.userArgsForCall)
+func (fake *FakeClient) RepositoryPermissionCallCount() int {
+	fake.repositoryPermissionMutex.RLock()
+	defer fake.repositoryPermissionMutex.RUnlock()
+	return len(fake.repositoryPermissionArgsForCall)
 }
 
-func (fake *FakeClient) UserCalls(stub func(context.Context, string) (client.User, error)) {
-	fake.userMutex.Lock()
-	defer fake.userMutex.Unlock()
-	fake.UserStub = stub
+func (fake *FakeClient) RepositoryPermissionCalls(stub func(context.Context, string) (githubv4.RepositoryPermission, error)) {
+	fake.repositoryPermissionMutex.Lock()
+	defer fake.repositoryPermissionMutex.Unlock()
+	fake.RepositoryPermissionStub = stub
 }
 
-func (fake *FakeClient) UserArgsForCall(i int) (context.Context, string) {
-	fake.userMutex.RLock()
-	defer fake.userMutex.RUnlock()
-	argsForCall := fake.userArgsForCall[i]
+func (fake *FakeClient) RepositoryPermissionArgsForCall(i int) (context.Context, string) {
+	fake.repositoryPermissionMutex.RLock()
+	defer fake.repositoryPermissionMutex.RUnlock()
+	argsForCall := fake.repositoryPermissionArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeClient) UserReturns(result1 client.User, result2 error) {
-	fake.userMutex.Lock()
-	defer fake.userMutex.Unlock()
-	fake.UserStub = nil
-	fake.userReturns = struct {
-		result1 client.User
+func (fake *FakeClient) RepositoryPermissionReturns(result1 githubv4.RepositoryPermission, result2 error) {
+	fake.repositoryPermissionMutex.Lock()
+	defer fake.repositoryPermissionMutex.Unlock()
+	fake.RepositoryPermissionStub = nil
+	fake.repositoryPermissionReturns = struct {
+		result1 githubv4.RepositoryPermission
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeClient) UserReturnsOnCall(i int, result1 client.User, result2 error) {
-	fake.userMutex.Lock()
-	defer fake.userMutex.Unlock()
-	fake.UserStub = nil
-	if fake.userReturnsOnCall == nil {
-		fake.userReturnsOnCall = make(map[int]struct {
-			result1 client.User
+func (fake *FakeClient) RepositoryPermissionReturnsOnCall(i int, result1 githubv4.RepositoryPermission, result2 error) {
+	fake.repositoryPermissionMutex.Lock()
+	defer fake.repositoryPermissionMutex.Unlock()
+	fake.RepositoryPermissionStub = nil
+	if fake.repositoryPermissionReturnsOnCall == nil {
+		fake.repositoryPermissionReturnsOnCall = make(map[int]struct {
+			result1 githubv4.RepositoryPermission
 			result2 error
 		})
 	}
-	fake.userReturnsOnCall[i] = struct {
-		result1 client.User
+	fake.repositoryPermissionReturnsOnCall[i] = struct {
+		result1 githubv4.RepositoryPermission
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) RequestReviews(arg1 context.Context, arg2 githubv4.ID, arg3 []githubv4.ID, arg4 []githubv4.ID) error {
+	var arg3Copy []githubv4.ID
+	if arg3 != nil {
+		arg3Copy = make([]githubv4.ID, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	var arg4Copy []githubv4.ID
+	if arg4 != nil {
+		arg4Copy = make([]githubv4.ID, len(arg4))
+		copy(arg4Copy, arg4)
+	}
+	fake.requestReviewsMutex.Lock()
+	ret, specificReturn := fake.requestReviewsReturnsOnCall[len(fake.requestReviewsArgsForCall)]
+	fake.requestReviewsArgsForCall = append(fake.requestReviewsArgsForCall, struct {
+		arg1 context.Context
+		arg2 githubv4.ID
+		arg3 []githubv4.ID
+		arg4 []githubv4.ID
+	}{arg1, arg2, arg3Copy, arg4Copy})
+	stub := fake.RequestReviewsStub
+	fakeReturns := fake.requestReviewsReturns
+	fake.recordInvocation("RequestReviews", []interface{}{arg1, arg2, arg3Copy, arg4Copy})
+	fake.requestReviewsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) RequestReviewsCallCount() int {
+	fake.requestReviewsMutex.RLock()
+	defer fake.requestReviewsMutex.RUnlock()
+	return len(fake.requestReviewsArgsForCall)
+}
+
+func (fake *FakeClient) RequestReviewsCalls(stub func(context.Context, githubv4.ID, []githubv4.ID, []githubv4.ID) error) {
+	fake.requestReviewsMutex.Lock()
+	defer fake.requestReviewsMutex.Unlock()
+	fake.RequestReviewsStub = stub
+}
+
+func (fake *FakeClient) RequestReviewsArgsForCall(i int) (context.Context, githubv4.ID, []githubv4.ID, []githubv4.ID) {
+	fake.requestReviewsMutex.RLock()
+	defer fake.requestReviewsMutex.RUnlock()
+	argsForCall := fake.requestReviewsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeClient) RequestReviewsReturns(result1 error) {
+	fake.requestReviewsMutex.Lock()
+	defer fake.requestReviewsMutex.Unlock()
+	fake.RequestReviewsStub = nil
+	fake.requestReviewsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) RequestReviewsReturnsOnCall(i int, result1 error) {
+	fake.requestReviewsMutex.Lock()
+	defer fake.requestReviewsMutex.Unlock()
+	fake.RequestReviewsStub = nil
+	if fake.requestReviewsReturnsOnCall == nil {
+		fake.requestReviewsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.requestReviewsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) SearchRepositories(arg1 context.Context, arg2 string) ([]string, error) {
+	fake.searchRepositoriesMutex.Lock()
+	ret, specificReturn := fake.searchRepositoriesReturnsOnCall[len(fake.searchRepositoriesArgsForCall)]
+	fake.searchRepositoriesArgsForCall = append(fake.searchRepositoriesArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.SearchRepositoriesStub
+	fakeReturns := fake.searchRepositoriesReturns
+	fake.recordInvocation("SearchRepositories", []interface{}{arg1, arg2})
+	fake.searchRepositoriesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) SearchRepositoriesCallCount() int {
+	fake.searchRepositoriesMutex.RLock()
+	defer fake.searchRepositoriesMutex.RUnlock()
+	return len(fake.searchRepositoriesArgsForCall)
+}
+
+func (fake *FakeClient) SearchRepositoriesCalls(stub func(context.Context, string) ([]string, error)) {
+	fake.searchRepositoriesMutex.Lock()
+	defer fake.searchRepositoriesMutex.Unlock()
+	fake.SearchRepositoriesStub = stub
+}
+
+func (fake *FakeClient) SearchRepositoriesArgsForCall(i int) (context.Context, string) {
+	fake.searchRepositoriesMutex.RLock()
+	defer fake.searchRepositoriesMutex.RUnlock()
+	argsForCall := fake.searchRepositoriesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) SearchRepositoriesReturns(result1 []string, result2 error) {
+	fake.searchRepositoriesMutex.Lock()
+	defer fake.searchRepositoriesMutex.Unlock()
+	fake.SearchRepositoriesStub = nil
+	fake.searchRepositoriesReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) SearchRepositoriesReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.searchRepositoriesMutex.Lock()
+	defer fake.searchRepositoriesMutex.Unlock()
+	fake.SearchRepositoriesStub = nil
+	if fake.searchRepositoriesReturnsOnCall == nil {
+		fake.searchRepositoriesReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.searchRepositoriesReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) SetProjectItemField(arg1 context.Context, arg2 githubv4.String, arg3 githubv4.String, arg4 string, arg5 string) error {
+	fake.setProjectItemFieldMutex.Lock()
+	ret, specificReturn := fake.setProjectItemFieldReturnsOnCall[len(fake.setProjectItemFieldArgsForCall)]
+	fake.setProjectItemFieldArgsForCall = append(fake.setProjectItemFieldArgsForCall, struct {
+		arg1 context.Context
+		arg2 githubv4.String
+		arg3 githubv4.String
+		arg4 string
+		arg5 string
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.SetProjectItemFieldStub
+	fakeReturns := fake.setProjectItemFieldReturns
+	fake.recordInvocation("SetProjectItemField", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.setProjectItemFieldMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) SetProjectItemFieldCallCount() int {
+	fake.setProjectItemFieldMutex.RLock()
+	defer fake.setProjectItemFieldMutex.RUnlock()
+	return len(fake.setProjectItemFieldArgsForCall)
+}
+
+func (fake *FakeClient) SetProjectItemFieldCalls(stub func(context.Context, githubv4.String, githubv4.String, string, string) error) {
+	fake.setProjectItemFieldMutex.Lock()
+	defer fake.setProjectItemFieldMutex.Unlock()
+	fake.SetProjectItemFieldStub = stub
+}
+
+func (fake *FakeClient) SetProjectItemFieldArgsForCall(i int) (context.Context, githubv4.String, githubv4.String, string, string) {
+	fake.setProjectItemFieldMutex.RLock()
+	defer fake.setProjectItemFieldMutex.RUnlock()
+	argsForCall := fake.setProjectItemFieldArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeClient) SetProjectItemFieldReturns(result1 error) {
+	fake.setProjectItemFieldMutex.Lock()
+	defer fake.setProjectItemFieldMutex.Unlock()
+	fake.SetProjectItemFieldStub = nil
+	fake.setProjectItemFieldReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) SetProjectItemFieldReturnsOnCall(i int, result1 error) {
+	fake.setProjectItemFieldMutex.Lock()
+	defer fake.setProjectItemFieldMutex.Unlock()
+	fake.SetProjectItemFieldStub = nil
+	if fake.setProjectItemFieldReturnsOnCall == nil {
+		fake.setProjectItemFieldReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setProjectItemFieldReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) Team(arg1 context.Context, arg2 string, arg3 string) (client.Team, error) {
+	fake.teamMutex.Lock()
+	ret, specificReturn := fake.teamReturnsOnCall[len(fake.teamArgsForCall)]
+	fake.teamArgsForCall = append(fake.teamArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.TeamStub
+	fakeReturns := fake.teamReturns
+	fake.recordInvocation("Team", []interface{}{arg1, arg2, arg3})
+	fake.teamMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) TeamCallCount() int {
+	fake.teamMutex.RLock()
+	defer fake.teamMutex.RUnlock()
+	return len(fake.teamArgsForCall)
+}
+
+func (fake *FakeClient) TeamCalls(stub func(context.Context, string, string) (client.Team, error)) {
+	fake.teamMutex.Lock()
+	defer fake.teamMutex.Unlock()
+	fake.TeamStub = stub
+}
+
+func (fake *FakeClient) TeamArgsForCall(i int) (context.Context, string, string) {
+	fake.teamMutex.RLock()
+	defer fake.teamMutex.RUnlock()
+	argsForCall := fake.teamArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) TeamReturns(result1 client.Team, result2 error) {
+	fake.teamMutex.Lock()
+	defer fake.teamMutex.Unlock()
+	fake.TeamStub = nil
+	fake.teamReturns = struct {
+		result1 client.Team
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) TeamReturnsOnCall(i int, result1 client.Team, result2 error) {
+	fake.teamMutex.Lock()
+	defer fake.teamMutex.Unlock()
+	fake.TeamStub = nil
+	if fake.teamReturnsOnCall == nil {
+		fake.teamReturnsOnCall = make(map[int]struct {
+			result1 client.Team
+			result2 error
+		})
+	}
+	fake.teamReturnsOnCall[i] = struct {
+		result1 client.Team
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) UnarchiveProjectItem(arg1 context.Context, arg2 githubv4.ID, arg3 githubv4.ID) error {
+	fake.unarchiveProjectItemMutex.Lock()
+	ret, specificReturn := fake.unarchiveProjectItemReturnsOnCall[len(fake.unarchiveProjectItemArgsForCall)]
+	fake.unarchiveProjectItemArgsForCall = append(fake.unarchiveProjectItemArgsForCall, struct {
+		arg1 context.Context
+		arg2 githubv4.ID
+		arg3 githubv4.ID
+	}{arg1, arg2, arg3})
+	stub := fake.UnarchiveProjectItemStub
+	fakeReturns := fake.unarchiveProjectItemReturns
+	fake.recordInvocation("UnarchiveProjectItem", []interface{}{arg1, arg2, arg3})
+	fake.unarchiveProjectItemMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) UnarchiveProjectItemCallCount() int {
+	fake.unarchiveProjectItemMutex.RLock()
+	defer fake.unarchiveProjectItemMutex.RUnlock()
+	return len(fake.unarchiveProjectItemArgsForCall)
+}
+
+func (fake *FakeClient) UnarchiveProjectItemCalls(stub func(context.Context, githubv4.ID, githubv4.ID) error) {
+	fake.unarchiveProjectItemMutex.Lock()
+	defer fake.unarchiveProjectItemMutex.Unlock()
+	fake.UnarchiveProjectItemStub = stub
+}
+
+func (fake *FakeClient) UnarchiveProjectItemArgsForCall(i int) (context.Context, githubv4.ID, githubv4.ID) {
+	fake.unarchiveProjectItemMutex.RLock()
+	defer fake.unarchiveProjectItemMutex.RUnlock()
+	argsForCall := fake.unarchiveProjectItemArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) UnarchiveProjectItemReturns(result1 error) {
+	fake.unarchiveProjectItemMutex.Lock()
+	defer fake.unarchiveProjectItemMutex.Unlock()
+	fake.UnarchiveProjectItemStub = nil
+	fake.unarchiveProjectItemReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) UnarchiveProjectItemReturnsOnCall(i int, result1 error) {
+	fake.unarchiveProjectItemMutex.Lock()
+	defer fake.unarchiveProjectItemMutex.Unlock()
+	fake.UnarchiveProjectItemStub = nil
+	if fake.unarchiveProjectItemReturnsOnCall == nil {
+		fake.unarchiveProjectItemReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.unarchiveProjectItemReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) UnassignUserFromAssignable(arg1 context.Context, arg2 githubv4.ID, arg3 githubv4.ID) error {
+	fake.unassignUserFromAssignableMutex.Lock()
+	ret, specificReturn := fake.unassignUserFromAssignableReturnsOnCall[len(fake.unassignUserFromAssignableArgsForCall)]
+	fake.unassignUserFromAssignableArgsForCall = append(fake.unassignUserFromAssignableArgsForCall, struct {
+		arg1 context.Context
+		arg2 githubv4.ID
+		arg3 githubv4.ID
+	}{arg1, arg2, arg3})
+	stub := fake.UnassignUserFromAssignableStub
+	fakeReturns := fake.unassignUserFromAssignableReturns
+	fake.recordInvocation("UnassignUserFromAssignable", []interface{}{arg1, arg2, arg3})
+	fake.unassignUserFromAssignableMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) UnassignUserFromAssignableCallCount() int {
+	fake.unassignUserFromAssignableMutex.RLock()
+	defer fake.unassignUserFromAssignableMutex.RUnlock()
+	return len(fake.unassignUserFromAssignableArgsForCall)
+}
+
+func (fake *FakeClient) UnassignUserFromAssignableCalls(stub func(context.Context, githubv4.ID, githubv4.ID) error) {
+	fake.unassignUserFromAssignableMutex.Lock()
+	defer fake.unassignUserFromAssignableMutex.Unlock()
+	fake.UnassignUserFromAssignableStub = stub
+}
+
+func (fake *FakeClient) UnassignUserFromAssignableArgsForCall(i int) (context.Context, githubv4.ID, githubv4.ID) {
+	fake.unassignUserFromAssignableMutex.RLock()
+	defer fake.unassignUserFromAssignableMutex.RUnlock()
+	argsForCall := fake.unassignUserFromAssignableArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) UnassignUserFromAssignableReturns(result1 error) {
+	fake.unassignUserFromAssignableMutex.Lock()
+	defer fake.unassignUserFromAssignableMutex.Unlock()
+	fake.UnassignUserFromAssignableStub = nil
+	fake.unassignUserFromAssignableReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) UnassignUserFromAssignableReturnsOnCall(i int, result1 error) {
+	fake.unassignUserFromAssignableMutex.Lock()
+	defer fake.unassignUserFromAssignableMutex.Unlock()
+	fake.UnassignUserFromAssignableStub = nil
+	if fake.unassignUserFromAssignableReturnsOnCall == nil {
+		fake.unassignUserFromAssignableReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.unassignUserFromAssignableReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) UpdateComment(arg1 context.Context, arg2 githubv4.ID, arg3 string) error {
+	fake.updateCommentMutex.Lock()
+	ret, specificReturn := fake.updateCommentReturnsOnCall[len(fake.updateCommentArgsForCall)]
+	fake.updateCommentArgsForCall = append(fake.updateCommentArgsForCall, struct {
+		arg1 context.Context
+		arg2 githubv4.ID
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateCommentStub
+	fakeReturns := fake.updateCommentReturns
+	fake.recordInvocation("UpdateComment", []interface{}{arg1, arg2, arg3})
+	fake.updateCommentMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) UpdateCommentCallCount() int {
+	fake.updateCommentMutex.RLock()
+	defer fake.updateCommentMutex.RUnlock()
+	return len(fake.updateCommentArgsForCall)
+}
+
+func (fake *FakeClient) UpdateCommentCalls(stub func(context.Context, githubv4.ID, string) error) {
+	fake.updateCommentMutex.Lock()
+	defer fake.updateCommentMutex.Unlock()
+	fake.UpdateCommentStub = stub
+}
+
+func (fake *FakeClient) UpdateCommentArgsForCall(i int) (context.Context, githubv4.ID, string) {
+	fake.updateCommentMutex.RLock()
+	defer fake.updateCommentMutex.RUnlock()
+	argsForCall := fake.updateCommentArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) UpdateCommentReturns(result1 error) {
+	fake.updateCommentMutex.Lock()
+	defer fake.updateCommentMutex.Unlock()
+	fake.UpdateCommentStub = nil
+	fake.updateCommentReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) UpdateCommentReturnsOnCall(i int, result1 error) {
+	fake.updateCommentMutex.Lock()
+	defer fake.updateCommentMutex.Unlock()
+	fake.UpdateCommentStub = nil
+	if fake.updateCommentReturnsOnCall == nil {
+		fake.updateCommentReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateCommentReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) UpdateIssueStatus(arg1 context.Context, arg2 client.GenericIssue, arg3 githubv4.String, arg4 int) (client.StatusResult, error) {
+	fake.updateIssueStatusMutex.Lock()
+	ret, specificReturn := fake.updateIssueStatusReturnsOnCall[len(fake.updateIssueStatusArgsForCall)]
+	fake.updateIssueStatusArgsForCall = append(fake.updateIssueStatusArgsForCall, struct {
+		arg1 context.Context
+		arg2 client.GenericIssue
+		arg3 githubv4.String
+		arg4 int
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.UpdateIssueStatusStub
+	fakeReturns := fake.updateIssueStatusReturns
+	fake.recordInvocation("UpdateIssueStatus", []interface{}{arg1, arg2, arg3, arg4})
+	fake.updateIssueStatusMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) UpdateIssueStatusCallCount() int {
+	fake.updateIssueStatusMutex.RLock()
+	defer fake.updateIssueStatusMutex.RUnlock()
+	return len(fake.updateIssueStatusArgsForCall)
+}
+
+func (fake *FakeClient) UpdateIssueStatusCalls(stub func(context.Context, client.GenericIssue, githubv4.String, int) (client.StatusResult, error)) {
+	fake.updateIssueStatusMutex.Lock()
+	defer fake.updateIssueStatusMutex.Unlock()
+	fake.UpdateIssueStatusStub = stub
+}
+
+func (fake *FakeClient) UpdateIssueStatusArgsForCall(i int) (context.Context, client.GenericIssue, githubv4.String, int) {
+	fake.updateIssueStatusMutex.RLock()
+	defer fake.updateIssueStatusMutex.RUnlock()
+	argsForCall := fake.updateIssueStatusArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeClient) UpdateIssueStatusReturns(result1 client.StatusResult, result2 error) {
+	fake.updateIssueStatusMutex.Lock()
+	defer fake.updateIssueStatusMutex.Unlock()
+	fake.UpdateIssueStatusStub = nil
+	fake.updateIssueStatusReturns = struct {
+		result1 client.StatusResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) UpdateIssueStatusReturnsOnCall(i int, result1 client.StatusResult, result2 error) {
+	fake.updateIssueStatusMutex.Lock()
+	defer fake.updateIssueStatusMutex.Unlock()
+	fake.UpdateIssueStatusStub = nil
+	if fake.updateIssueStatusReturnsOnCall == nil {
+		fake.updateIssueStatusReturnsOnCall = make(map[int]struct {
+			result1 client.StatusResult
+			result2 error
+		})
+	}
+	fake.updateIssueStatusReturnsOnCall[i] = struct {
+		result1 client.StatusResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) UpdatePullRequestBody(arg1 context.Context, arg2 githubv4.ID, arg3 string) error {
+	fake.updatePullRequestBodyMutex.Lock()
+	ret, specificReturn := fake.updatePullRequestBodyReturnsOnCall[len(fake.updatePullRequestBodyArgsForCall)]
+	fake.updatePullRequestBodyArgsForCall = append(fake.updatePullRequestBodyArgsForCall, struct {
+		arg1 context.Context
+		arg2 githubv4.ID
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.UpdatePullRequestBodyStub
+	fakeReturns := fake.updatePullRequestBodyReturns
+	fake.recordInvocation("UpdatePullRequestBody", []interface{}{arg1, arg2, arg3})
+	fake.updatePullRequestBodyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) UpdatePullRequestBodyCallCount() int {
+	fake.updatePullRequestBodyMutex.RLock()
+	defer fake.updatePullRequestBodyMutex.RUnlock()
+	return len(fake.updatePullRequestBodyArgsForCall)
+}
+
+func (fake *FakeClient) UpdatePullRequestBodyCalls(stub func(context.Context, githubv4.ID, string) error) {
+	fake.updatePullRequestBodyMutex.Lock()
+	defer fake.updatePullRequestBodyMutex.Unlock()
+	fake.UpdatePullRequestBodyStub = stub
+}
+
+func (fake *FakeClient) UpdatePullRequestBodyArgsForCall(i int) (context.Context, githubv4.ID, string) {
+	fake.updatePullRequestBodyMutex.RLock()
+	defer fake.updatePullRequestBodyMutex.RUnlock()
+	argsForCall := fake.updatePullRequestBodyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) UpdatePullRequestBodyReturns(result1 error) {
+	fake.updatePullRequestBodyMutex.Lock()
+	defer fake.updatePullRequestBodyMutex.Unlock()
+	fake.UpdatePullRequestBodyStub = nil
+	fake.updatePullRequestBodyReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) UpdatePullRequestBodyReturnsOnCall(i int, result1 error) {
+	fake.updatePullRequestBodyMutex.Lock()
+	defer fake.updatePullRequestBodyMutex.Unlock()
+	fake.UpdatePullRequestBodyStub = nil
+	if fake.updatePullRequestBodyReturnsOnCall == nil {
+		fake.updatePullRequestBodyReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updatePullRequestBodyReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) User(arg1 context.Context, arg2 string) (client.User, error) {
+	fake.userMutex.Lock()
+	ret, specificReturn := fake.userReturnsOnCall[len(fake.userArgsForCall)]
+	fake.userArgsForCall = append(fake.userArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.UserStub
+	fakeReturns := fake.userReturns
+	fake.recordInvocation("User", []interface{}{arg1, arg2})
+	fake.userMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) UserCallCount() int {
+	fake.userMutex.RLock()
+	defer fake.userMutex.RUnlock()
+	return len(fake.userArgsForCall)
+}
+
+func (fake *FakeClient) UserCalls(stub func(context.Context, string) (client.User, error)) {
+	fake.userMutex.Lock()
+	defer fake.userMutex.Unlock()
+	fake.UserStub = stub
+}
+
+func (fake *FakeClient) UserArgsForCall(i int) (context.Context, string) {
+	fake.userMutex.RLock()
+	defer fake.userMutex.RUnlock()
+	argsForCall := fake.userArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) UserReturns(result1 client.User, result2 error) {
+	fake.userMutex.Lock()
+	defer fake.userMutex.Unlock()
+	fake.UserStub = nil
+	fake.userReturns = struct {
+		result1 client.User
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) UserReturnsOnCall(i int, result1 client.User, result2 error) {
+	fake.userMutex.Lock()
+	defer fake.userMutex.Unlock()
+	fake.UserStub = nil
+	if fake.userReturnsOnCall == nil {
+		fake.userReturnsOnCall = make(map[int]struct {
+			result1 client.User
+			result2 error
+		})
+	}
+	fake.userReturnsOnCall[i] = struct {
+		result1 client.User
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ViewerHasReacted(arg1 context.Context, arg2 githubv4.ID, arg3 githubv4.ReactionContent) (bool, error) {
+	fake.viewerHasReactedMutex.Lock()
+	ret, specificReturn := fake.viewerHasReactedReturnsOnCall[len(fake.viewerHasReactedArgsForCall)]
+	fake.viewerHasReactedArgsForCall = append(fake.viewerHasReactedArgsForCall, struct {
+		arg1 context.Context
+		arg2 githubv4.ID
+		arg3 githubv4.ReactionContent
+	}{arg1, arg2, arg3})
+	stub := fake.ViewerHasReactedStub
+	fakeReturns := fake.viewerHasReactedReturns
+	fake.recordInvocation("ViewerHasReacted", []interface{}{arg1, arg2, arg3})
+	fake.viewerHasReactedMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) ViewerHasReactedCallCount() int {
+	fake.viewerHasReactedMutex.RLock()
+	defer fake.viewerHasReactedMutex.RUnlock()
+	return len(fake.viewerHasReactedArgsForCall)
+}
+
+func (fake *FakeClient) ViewerHasReactedCalls(stub func(context.Context, githubv4.ID, githubv4.ReactionContent) (bool, error)) {
+	fake.viewerHasReactedMutex.Lock()
+	defer fake.viewerHasReactedMutex.Unlock()
+	fake.ViewerHasReactedStub = stub
+}
+
+func (fake *FakeClient) ViewerHasReactedArgsForCall(i int) (context.Context, githubv4.ID, githubv4.ReactionContent) {
+	fake.viewerHasReactedMutex.RLock()
+	defer fake.viewerHasReactedMutex.RUnlock()
+	argsForCall := fake.viewerHasReactedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) ViewerHasReactedReturns(result1 bool, result2 error) {
+	fake.viewerHasReactedMutex.Lock()
+	defer fake.viewerHasReactedMutex.Unlock()
+	fake.ViewerHasReactedStub = nil
+	fake.viewerHasReactedReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ViewerHasReactedReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.viewerHasReactedMutex.Lock()
+	defer fake.viewerHasReactedMutex.Unlock()
+	fake.ViewerHasReactedStub = nil
+	if fake.viewerHasReactedReturnsOnCall == nil {
+		fake.viewerHasReactedReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.viewerHasReactedReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ViewerLogin(arg1 context.Context) (string, error) {
+	fake.viewerLoginMutex.Lock()
+	ret, specificReturn := fake.viewerLoginReturnsOnCall[len(fake.viewerLoginArgsForCall)]
+	fake.viewerLoginArgsForCall = append(fake.viewerLoginArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.ViewerLoginStub
+	fakeReturns := fake.viewerLoginReturns
+	fake.recordInvocation("ViewerLogin", []interface{}{arg1})
+	fake.viewerLoginMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) ViewerLoginCallCount() int {
+	fake.viewerLoginMutex.RLock()
+	defer fake.viewerLoginMutex.RUnlock()
+	return len(fake.viewerLoginArgsForCall)
+}
+
+func (fake *FakeClient) ViewerLoginCalls(stub func(context.Context) (string, error)) {
+	fake.viewerLoginMutex.Lock()
+	defer fake.viewerLoginMutex.Unlock()
+	fake.ViewerLoginStub = stub
+}
+
+func (fake *FakeClient) ViewerLoginArgsForCall(i int) context.Context {
+	fake.viewerLoginMutex.RLock()
+	defer fake.viewerLoginMutex.RUnlock()
+	argsForCall := fake.viewerLoginArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) ViewerLoginReturns(result1 string, result2 error) {
+	fake.viewerLoginMutex.Lock()
+	defer fake.viewerLoginMutex.Unlock()
+	fake.ViewerLoginStub = nil
+	fake.viewerLoginReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ViewerLoginReturnsOnCall(i int, result1 string, result2 error) {
+	fake.viewerLoginMutex.Lock()
+	defer fake.viewerLoginMutex.Unlock()
+	fake.ViewerLoginStub = nil
+	if fake.viewerLoginReturnsOnCall == nil {
+		fake.viewerLoginReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.viewerLoginReturnsOnCall[i] = struct {
+		result1 string
 		result2 error
 	}{result1, result2}
 }
@@ -951,16 +2371,28 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.addLabelMutex.RLock()
 	defer fake.addLabelMutex.RUnlock()
+	fake.addProjectItemMutex.RLock()
+	defer fake.addProjectItemMutex.RUnlock()
 	fake.addReactionMutex.RLock()
 	defer fake.addReactionMutex.RUnlock()
-	fake.assignIssueToProjectMutex.RLock()
-	defer fake.assignIssueToProjectMutex.RUnlock()
+	fake.archiveProjectItemMutex.RLock()
+	defer fake.archiveProjectItemMutex.RUnlock()
 	fake.assignUserToAssignableMutex.RLock()
 	defer fake.assignUserToAssignableMutex.RUnlock()
+	fake.clearIssueStatusMutex.RLock()
+	defer fake.clearIssueStatusMutex.RUnlock()
+	fake.commentsMutex.RLock()
+	defer fake.commentsMutex.RUnlock()
+	fake.convertDraftIssueMutex.RLock()
+	defer fake.convertDraftIssueMutex.RUnlock()
+	fake.deleteProjectItemMutex.RLock()
+	defer fake.deleteProjectItemMutex.RUnlock()
 	fake.issueMutex.RLock()
 	defer fake.issueMutex.RUnlock()
 	fake.leaveCommentMutex.RLock()
 	defer fake.leaveCommentMutex.RUnlock()
+	fake.projectMutex.RLock()
+	defer fake.projectMutex.RUnlock()
 	fake.projectItemsMutex.RLock()
 	defer fake.projectItemsMutex.RUnlock()
 	fake.pullRequestMutex.RLock()
@@ -969,10 +2401,34 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.pullRequestsMutex.RUnlock()
 	fake.removeLabelMutex.RLock()
 	defer fake.removeLabelMutex.RUnlock()
+	fake.repositoriesMutex.RLock()
+	defer fake.repositoriesMutex.RUnlock()
+	fake.repositoryPermissionMutex.RLock()
+	defer fake.repositoryPermissionMutex.RUnlock()
+	fake.requestReviewsMutex.RLock()
+	defer fake.requestReviewsMutex.RUnlock()
+	fake.searchRepositoriesMutex.RLock()
+	defer fake.searchRepositoriesMutex.RUnlock()
+	fake.setProjectItemFieldMutex.RLock()
+	defer fake.setProjectItemFieldMutex.RUnlock()
+	fake.teamMutex.RLock()
+	defer fake.teamMutex.RUnlock()
+	fake.unarchiveProjectItemMutex.RLock()
+	defer fake.unarchiveProjectItemMutex.RUnlock()
+	fake.unassignUserFromAssignableMutex.RLock()
+	defer fake.unassignUserFromAssignableMutex.RUnlock()
+	fake.updateCommentMutex.RLock()
+	defer fake.updateCommentMutex.RUnlock()
 	fake.updateIssueStatusMutex.RLock()
 	defer fake.updateIssueStatusMutex.RUnlock()
+	fake.updatePullRequestBodyMutex.RLock()
+	defer fake.updatePullRequestBodyMutex.RUnlock()
 	fake.userMutex.RLock()
 	defer fake.userMutex.RUnlock()
+	fake.viewerHasReactedMutex.RLock()
+	defer fake.viewerHasReactedMutex.RUnlock()
+	fake.viewerLoginMutex.RLock()
+	defer fake.viewerLoginMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
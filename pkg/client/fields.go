@@ -0,0 +1,219 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shurcooL/githubv4"
+)
+
+// ProjectField is a field of a project. Options are only set for single select fields.
+type ProjectField struct {
+	ID       githubv4.String
+	Name     githubv4.String
+	DataType githubv4.ProjectV2FieldType
+	Options  []struct {
+		ID   githubv4.String
+		Name githubv4.String
+	}
+}
+
+// Option returns the ID of the option with the given name, ignoring case.
+func (f ProjectField) Option(name string) (githubv4.String, bool) {
+	for _, o := range f.Options {
+		if strings.EqualFold(string(o.Name), name) {
+			return o.ID, true
+		}
+	}
+
+	return "", false
+}
+
+type projectFieldCommon struct {
+	ID       githubv4.String
+	Name     githubv4.String
+	DataType githubv4.ProjectV2FieldType
+}
+
+// AddProjectItem adds the issue or pull request to the project and returns the new item. If the content
+// is in the project already, GitHub returns the existing item.
+func (c *Caretaker) AddProjectItem(
+	ctx context.Context,
+	projectID githubv4.String,
+	contentID githubv4.ID,
+) (ProjectV2Item, error) {
+	var addProjectV2ItemByID struct {
+		AddProjectV2ItemById struct { //nolint:stylecheck,revive // this needs to be Id.
+			Item ProjectV2Item
+		} `graphql:"addProjectV2ItemById(input: $input)"`
+	}
+
+	input := githubv4.AddProjectV2ItemByIdInput{
+		ProjectID: projectID,
+		ContentID: contentID,
+	}
+
+	if err := c.gclient.Mutate(ctx, &addProjectV2ItemByID, input, nil); err != nil {
+		return ProjectV2Item{}, fmt.Errorf("failed to add item to project: %w", err)
+	}
+
+	return addProjectV2ItemByID.AddProjectV2ItemById.Item, nil
+}
+
+// SetProjectItemField sets the field with the name to the value. Text, number, date (YYYY-MM-DD) and single
+// select fields are supported; single select options are matched by name, ignoring case.
+func (c *Caretaker) SetProjectItemField(
+	ctx context.Context,
+	projectID, itemID githubv4.String,
+	name, value string,
+) error {
+	if strings.EqualFold(name, "Status") {
+		field, option, err := c.statusOption(ctx, projectID, githubv4.String(value))
+		if err != nil {
+			return err
+		}
+
+		// like the options of other single select fields, the initial Status is matched ignoring case
+		if option == "" {
+			option, _ = field.OptionIgnoringCase(value)
+		}
+
+		if option == "" {
+			return &Error{Kind: ErrStatusOptionNotFound, Err: fmt.Errorf("status option %s not found", value)}
+		}
+
+		if err := c.setStatus(ctx, projectID, itemID, field.ID, option); err != nil {
+			return fmt.Errorf("failed to set status: %w", err)
+		}
+
+		return nil
+	}
+
+	field, err := c.projectField(ctx, projectID, name)
+	if err != nil {
+		return err
+	}
+
+	fieldValue, err := projectFieldValue(field, value)
+	if err != nil {
+		return err
+	}
+
+	var mutateFieldValue struct {
+		UpdateProjectV2ItemFieldValue struct {
+			ProjectV2Item struct {
+				ID githubv4.String
+			} `graphql:"projectV2Item"`
+		} `graphql:"updateProjectV2ItemFieldValue(input: $input)"`
+	}
+
+	input := githubv4.UpdateProjectV2ItemFieldValueInput{
+		ProjectID: githubv4.NewString(projectID),
+		ItemID:    githubv4.NewString(itemID),
+		FieldID:   githubv4.NewString(field.ID),
+		Value:     fieldValue,
+	}
+
+	if err := c.gclient.Mutate(ctx, &mutateFieldValue, input, nil); err != nil {
+		return fmt.Errorf("failed to set field %s: %w", name, err)
+	}
+
+	return nil
+}
+
+// projectFieldValue converts the value into the type of the field.
+func projectFieldValue(field ProjectField, value string) (githubv4.ProjectV2FieldValue, error) {
+	switch field.DataType {
+	case githubv4.ProjectV2FieldTypeText:
+		return githubv4.ProjectV2FieldValue{Text: githubv4.NewString(githubv4.String(value))}, nil
+	case githubv4.ProjectV2FieldTypeNumber:
+		number, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return githubv4.ProjectV2FieldValue{}, fmt.Errorf("invalid number %s for field %s", value, field.Name)
+		}
+
+		return githubv4.ProjectV2FieldValue{Number: githubv4.NewFloat(githubv4.Float(number))}, nil
+	case githubv4.ProjectV2FieldTypeDate:
+		date, err := time.Parse(time.DateOnly, value)
+		if err != nil {
+			return githubv4.ProjectV2FieldValue{},
+				fmt.Errorf("invalid date %s for field %s, wanted YYYY-MM-DD", value, field.Name)
+		}
+
+		return githubv4.ProjectV2FieldValue{Date: githubv4.NewDate(githubv4.Date{Time: date})}, nil
+	case githubv4.ProjectV2FieldTypeSingleSelect:
+		option, ok := field.Option(value)
+		if !ok {
+			return githubv4.ProjectV2FieldValue{}, &Error{
+				Kind: ErrNotFound,
+				Err:  fmt.Errorf("option %s not found in field %s", value, field.Name),
+			}
+		}
+
+		return githubv4.ProjectV2FieldValue{SingleSelectOptionID: githubv4.NewString(option)}, nil
+	default:
+		return githubv4.ProjectV2FieldValue{}, fmt.Errorf("field %s of type %s isn't supported", field.Name, field.DataType)
+	}
+}
+
+func projectFieldKey(projectID githubv4.String, name string) string {
+	return fmt.Sprintf("project-field:%s:%s", projectID, strings.ToLower(name))
+}
+
+// projectField returns the field of the project with the name.
+func (c *Caretaker) projectField(ctx context.Context, projectID githubv4.String, name string) (ProjectField, error) {
+	key := projectFieldKey(projectID, name)
+
+	var field ProjectField
+	if c.Cache.Get(key, &field) {
+		return field, nil
+	}
+
+	var fieldQuery struct {
+		Node struct {
+			ProjectV2 struct {
+				Field struct {
+					Field        projectFieldCommon `graphql:"... on ProjectV2Field"`
+					SingleSelect struct {
+						projectFieldCommon
+						Options []struct {
+							ID   githubv4.String
+							Name githubv4.String
+						}
+					} `graphql:"... on ProjectV2SingleSelectField"`
+					Iteration projectFieldCommon `graphql:"... on ProjectV2IterationField"`
+				} `graphql:"field(name: $name)"`
+			} `graphql:"... on ProjectV2"`
+		} `graphql:"node(id: $id)"`
+	}
+
+	if err := c.gclient.Query(ctx, &fieldQuery, map[string]any{
+		// a plain string is sent as the ID type
+		"id":   string(projectID),
+		"name": githubv4.String(name),
+	}); err != nil {
+		return ProjectField{}, fmt.Errorf("failed to get field %s of project: %w", name, err)
+	}
+
+	// the fields of all fragments are filled with the fields the response has
+	result := fieldQuery.Node.ProjectV2.Field
+	for _, common := range []projectFieldCommon{result.Field, result.SingleSelect.projectFieldCommon, result.Iteration} {
+		if common.ID != "" {
+			field.ID, field.Name, field.DataType = common.ID, common.Name, common.DataType
+
+			break
+		}
+	}
+
+	if field.ID == "" {
+		return ProjectField{}, &Error{Kind: ErrNotFound, Err: fmt.Errorf("field %s not found in project", name)}
+	}
+
+	field.Options = result.SingleSelect.Options
+	c.Cache.Set(key, field)
+
+	return field, nil
+}
@@ -0,0 +1,73 @@
+package client_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skarlso/caretaker/pkg/client"
+)
+
+func TestCaretaker_AddLabel(t *testing.T) {
+	tests := []struct {
+		name          string
+		label         string
+		create        bool
+		wantLabel     string
+		wantMutations []string
+		wantErr       string
+	}{
+		{
+			name:          "exact match on a later page of the fuzzy search",
+			label:         "bug",
+			wantLabel:     "Bug",
+			wantMutations: []string{"addLabelsToLabelable"},
+		},
+		{
+			name:    "missing label lists similar labels",
+			label:   "report",
+			wantErr: `label "report" not found, similar labels are: bug-report`,
+		},
+		{
+			name:          "missing label is created",
+			label:         "missing",
+			create:        true,
+			wantLabel:     "missing",
+			wantMutations: []string{"createLabel", "addLabelsToLabelable"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, c := newCaretaker(t, client.Options{Owner: "skarlso", Repo: "caretaker", CreateMissingLabels: tt.create})
+
+			r := s.AddRepository("skarlso", "caretaker")
+			for i := range 100 {
+				r.AddLabel(fmt.Sprintf("bug-%02d", i))
+			}
+
+			r.AddLabel("bug-report")
+			r.AddLabel("Bug")
+
+			issue := r.AddIssue("issue")
+
+			err := c.AddLabel(context.Background(), tt.label, githubv4.ID(issue.ID))
+			if tt.wantErr != "" {
+				require.ErrorIs(t, err, client.ErrLabelNotFound)
+				assert.EqualError(t, err, tt.wantErr)
+				assert.Empty(t, s.Mutations())
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, issue.Labels, 1)
+			assert.Equal(t, tt.wantLabel, issue.Labels[0].Name)
+			assert.Equal(t, tt.wantMutations, s.Mutations())
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package client_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skarlso/caretaker/pkg/client"
+)
+
+func TestCaretaker_ProjectOwnerIsOrganization(t *testing.T) {
+	override := true
+
+	tests := []struct {
+		name             string
+		owner            string
+		isOrganization   *bool
+		projectOwner     string
+		wantLookups      int
+		wantErr          error
+		wantErrSubstring string
+	}{
+		{
+			name:        "organization",
+			owner:       "open-source",
+			wantLookups: 1,
+		},
+		{
+			name:        "user",
+			owner:       "skarlso",
+			wantLookups: 1,
+		},
+		{
+			name:           "override",
+			owner:          "open-source",
+			isOrganization: &override,
+		},
+		{
+			name:           "project owner",
+			owner:          "skarlso",
+			isOrganization: &override,
+			projectOwner:   "open-source",
+			wantLookups:    1,
+		},
+		{
+			name:             "unknown owner",
+			owner:            "missing",
+			wantLookups:      1,
+			wantErr:          client.ErrNotFound,
+			wantErrSubstring: "owner missing not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, c := newCaretaker(t, client.Options{
+				Owner:          tt.owner,
+				IsOrganization: tt.isOrganization,
+				ProjectOwner:   tt.projectOwner,
+			})
+
+			s.AddOrganization("open-source")
+			s.AddProject("open-source", 1, "board", "Todo")
+			s.AddProject("skarlso", 1, "board", "Todo")
+
+			// the type is looked up once and cached, the items are queried for the matching type
+			for range 2 {
+				_, err := c.ProjectItems(context.Background(), 1, "")
+				if tt.wantErr != nil {
+					require.ErrorIs(t, err, tt.wantErr)
+					assert.ErrorContains(t, err, tt.wantErrSubstring)
+
+					break
+				}
+
+				require.NoError(t, err)
+			}
+
+			var lookups int
+
+			for _, query := range s.Queries() {
+				if query == "repositoryOwner" {
+					lookups++
+				}
+			}
+
+			assert.Equal(t, tt.wantLookups, lookups)
+		})
+	}
+}
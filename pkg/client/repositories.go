@@ -0,0 +1,114 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/shurcooL/githubv4"
+)
+
+// repositoriesPerPage is the number of repositories fetched with a single request.
+const repositoriesPerPage = 100
+
+type repositoryNode struct {
+	Name       githubv4.String
+	IsArchived githubv4.Boolean
+	Owner      struct {
+		Login githubv4.String
+	}
+}
+
+// Repositories returns the names of the repositories of the owner which aren't archived.
+func (c *Caretaker) Repositories(ctx context.Context) ([]string, error) {
+	var repositoriesQuery struct {
+		RepositoryOwner *struct {
+			Repositories struct {
+				Nodes    []repositoryNode
+				PageInfo PageInfo
+			} `graphql:"repositories(first: $first, after: $after)"`
+		} `graphql:"repositoryOwner(login: $login)"`
+	}
+
+	variables := map[string]any{
+		"login": githubv4.String(c.Owner),
+		"first": githubv4.Int(repositoriesPerPage),
+		"after": (*githubv4.String)(nil),
+	}
+
+	var result []string
+
+	for {
+		if err := c.gclient.Query(ctx, &repositoriesQuery, variables); err != nil {
+			return nil, fmt.Errorf("failed to list repositories of %s: %w", c.Owner, err)
+		}
+
+		if repositoriesQuery.RepositoryOwner == nil {
+			return nil, &Error{Kind: ErrNotFound, Err: fmt.Errorf("owner %s not found", c.Owner)}
+		}
+
+		page := repositoriesQuery.RepositoryOwner.Repositories
+		result = append(result, c.repositoryNames(page.Nodes)...)
+
+		if !page.PageInfo.HasNextPage {
+			return result, nil
+		}
+
+		variables["after"] = githubv4.NewString(page.PageInfo.EndCursor)
+	}
+}
+
+// SearchRepositories returns the names of the repositories of the owner which match the search query,
+// for example, topic:caretaker. Archived repositories are excluded.
+func (c *Caretaker) SearchRepositories(ctx context.Context, query string) ([]string, error) {
+	var searchQuery struct {
+		Search struct {
+			Nodes []struct {
+				Repository repositoryNode `graphql:"... on Repository"`
+			}
+			PageInfo PageInfo
+		} `graphql:"search(query: $query, type: REPOSITORY, first: $first, after: $after)"`
+	}
+
+	variables := map[string]any{
+		"query": githubv4.String(fmt.Sprintf("%s user:%s archived:false", query, c.Owner)),
+		"first": githubv4.Int(repositoriesPerPage),
+		"after": (*githubv4.String)(nil),
+	}
+
+	var result []string
+
+	for {
+		if err := c.gclient.Query(ctx, &searchQuery, variables); err != nil {
+			return nil, fmt.Errorf("failed to search repositories with %q: %w", query, err)
+		}
+
+		nodes := make([]repositoryNode, 0, len(searchQuery.Search.Nodes))
+		for _, n := range searchQuery.Search.Nodes {
+			nodes = append(nodes, n.Repository)
+		}
+
+		result = append(result, c.repositoryNames(nodes)...)
+
+		if !searchQuery.Search.PageInfo.HasNextPage {
+			return result, nil
+		}
+
+		variables["after"] = githubv4.NewString(searchQuery.Search.PageInfo.EndCursor)
+	}
+}
+
+// repositoryNames returns the names of the repositories of the owner which aren't archived.
+func (c *Caretaker) repositoryNames(nodes []repositoryNode) []string {
+	var result []string
+
+	for _, n := range nodes {
+		if bool(n.IsArchived) || !strings.EqualFold(string(n.Owner.Login), c.Owner) {
+			continue
+		}
+
+		result = append(result, string(n.Name))
+	}
+
+	return result
+}
@@ -0,0 +1,68 @@
+package client
+
+import (
+	"errors"
+)
+
+// StatusOutcome describes what happened to the Status of an issue in a single project.
+type StatusOutcome string
+
+const (
+	// StatusUpdated means the Status has been set.
+	StatusUpdated StatusOutcome = "updated"
+	// StatusSkippedClosed means the issue is closed and closed issues aren't moved.
+	StatusSkippedClosed StatusOutcome = "closed"
+	// StatusSkippedNotRequested means the project isn't the one that has been requested.
+	StatusSkippedNotRequested StatusOutcome = "not requested"
+	// StatusSkippedNotInProject means the issue doesn't have an item in the project.
+	StatusSkippedNotInProject StatusOutcome = "not in project"
+	// StatusSkippedAlreadySet means the item already has the requested Status.
+	StatusSkippedAlreadySet StatusOutcome = "already in status"
+	// StatusSkippedOptionNotFound means the Status field of the project doesn't have the requested option.
+	StatusSkippedOptionNotFound StatusOutcome = "option not found"
+)
+
+// ProjectStatusResult is the outcome of a status update in a single project.
+type ProjectStatusResult struct {
+	ProjectNumber int
+	ProjectTitle  string
+	Outcome       StatusOutcome
+	// FromStatus is the Status of the item before the update.
+	FromStatus string
+	// Options lists the options of the Status field if the requested option hasn't been found.
+	Options []string
+}
+
+// StatusResult lists the outcome of a status update for every project of an issue.
+type StatusResult struct {
+	Projects []ProjectStatusResult
+}
+
+// Updated returns true if the Status has been set in at least one project.
+func (r StatusResult) Updated() bool {
+	for _, p := range r.Projects {
+		if p.Outcome == StatusUpdated {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MissingOptions returns a *StatusOptionNotFoundError for every project the status option
+// hasn't been found in, joined into a single error, or nil.
+func (r StatusResult) MissingOptions(status string) error {
+	var errs []error
+
+	for _, p := range r.Projects {
+		if p.Outcome == StatusSkippedOptionNotFound {
+			errs = append(errs, &StatusOptionNotFoundError{
+				Status:        status,
+				ProjectNumber: p.ProjectNumber,
+				Options:       p.Options,
+			})
+		}
+	}
+
+	return errors.Join(errs...)
+}
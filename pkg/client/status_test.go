@@ -0,0 +1,127 @@
+package client_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skarlso/caretaker/pkg/client"
+)
+
+func TestCaretaker_UpdateIssueStatus(t *testing.T) {
+	tests := []struct {
+		name          string
+		strict        bool
+		projectNumber int
+		want          []client.StatusOutcome
+		wantErr       assert.ErrorAssertionFunc
+	}{
+		{
+			name: "reports the outcome for every project",
+			want: []client.StatusOutcome{
+				client.StatusUpdated,
+				client.StatusSkippedAlreadySet,
+				client.StatusSkippedOptionNotFound,
+				client.StatusSkippedNotInProject,
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name:   "strict fails on missing options after updating the other projects",
+			strict: true,
+			want: []client.StatusOutcome{
+				client.StatusUpdated,
+				client.StatusSkippedAlreadySet,
+				client.StatusSkippedOptionNotFound,
+				client.StatusSkippedNotInProject,
+			},
+			wantErr: func(t assert.TestingT, err error, _ ...interface{}) bool {
+				return assert.ErrorIs(t, err, client.ErrStatusOptionNotFound) &&
+					assert.EqualError(t, err, `status "Done" not found in project 3, available options are: Backlog, Shipped`)
+			},
+		},
+		{
+			name:          "only the requested project",
+			strict:        true,
+			projectNumber: 1,
+			want: []client.StatusOutcome{
+				client.StatusUpdated,
+				client.StatusSkippedNotRequested,
+				client.StatusSkippedNotRequested,
+				client.StatusSkippedNotRequested,
+			},
+			wantErr: assert.NoError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, c := newCaretaker(t, client.Options{Owner: "skarlso", Repo: "caretaker", Strict: tt.strict})
+
+			issue := s.AddRepository("skarlso", "caretaker").AddIssue("issue")
+			todo := s.AddProject("skarlso", 1, "todo", "Todo", "Done").AddItem(issue).SetStatus("Todo")
+			done := s.AddProject("skarlso", 2, "done", "Todo", "Done").AddItem(issue).SetStatus("Done")
+			backlog := s.AddProject("skarlso", 3, "backlog", "Backlog", "Shipped").AddItem(issue).SetStatus("Backlog")
+			other := s.AddProject("skarlso", 4, "other", "Todo", "Done")
+
+			ctx := context.Background()
+
+			fetched, err := c.Issue(ctx, issue.Number)
+			require.NoError(t, err)
+
+			// the projects of an issue can list a project without returning its item, for example, if the
+			// issue is in more projects than the items fetched
+			project, err := c.Project(ctx, other.Number)
+			require.NoError(t, err)
+
+			fetched.ProjectsV2.Nodes = append(fetched.ProjectsV2.Nodes, project)
+
+			result, err := c.UpdateIssueStatus(ctx, fetched, "Done", tt.projectNumber)
+			tt.wantErr(t, err)
+
+			var outcomes []client.StatusOutcome
+			for _, p := range result.Projects {
+				outcomes = append(outcomes, p.Outcome)
+			}
+
+			assert.Equal(t, tt.want, outcomes)
+			assert.Equal(t, []string{"Done", "Done", "Backlog"}, []string{todo.Status, done.Status, backlog.Status})
+			assert.Equal(t, []string{"updateProjectV2ItemFieldValue"}, s.Mutations())
+			assert.True(t, result.Updated())
+		})
+	}
+}
+
+func TestCaretaker_UpdateIssueStatus_MissingOptionQueriedOnce(t *testing.T) {
+	s, c := newCaretaker(t, client.Options{Owner: "skarlso", Repo: "caretaker"})
+
+	r := s.AddRepository("skarlso", "caretaker")
+	board := s.AddProject("skarlso", 1, "board", "Todo", "Done")
+	backlog := s.AddProject("skarlso", 2, "backlog", "Backlog", "Shipped")
+
+	ctx := context.Background()
+
+	for range 3 {
+		issue := r.AddIssue("issue")
+		board.AddItem(issue).SetStatus("Todo")
+		backlog.AddItem(issue).SetStatus("Backlog")
+
+		fetched, err := c.Issue(ctx, issue.Number)
+		require.NoError(t, err)
+
+		_, err = c.UpdateIssueStatus(ctx, fetched, "Done", -1)
+		require.NoError(t, err)
+	}
+
+	// the Status field of each project is queried once, even though the backlog doesn't have the option
+	var fieldQueries int
+
+	for _, query := range s.Queries() {
+		if query == "node" {
+			fieldQueries++
+		}
+	}
+
+	assert.Equal(t, 2, fieldQueries)
+}
@@ -0,0 +1,126 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/skarlso/caretaker/pkg/calendar"
+)
+
+// Config contains settings which are too complex to define through flags or action inputs.
+type Config struct {
+	// Commands defines custom slash commands.
+	Commands []Command `yaml:"commands"`
+	// Calendar defines the working calendar used to measure scan intervals.
+	Calendar calendar.Options `yaml:"calendar"`
+	// Transitions define the status transitions of scan-project.
+	Transitions []Transition `yaml:"transitions"`
+	// Assignments define the projects assign-issue adds issues to.
+	Assignments []Assignment `yaml:"assignments"`
+}
+
+// Assignment adds issues which match the conditions to a project and sets the fields of the new item.
+type Assignment struct {
+	// Project is the number of the project.
+	Project int `yaml:"project"`
+	// Labels restricts the assignment to issues with at least one of the labels.
+	Labels []string `yaml:"labels"`
+	// Title is a regular expression the title of the issue has to match, for example, `^\[RFC\]`.
+	Title string `yaml:"title"`
+	// Status is the initial Status of the new item.
+	Status string `yaml:"status"`
+	// Fields are the initial values of other fields by their names, for example, `Priority: High`.
+	// Text, number, date (YYYY-MM-DD) and single select fields are supported.
+	Fields map[string]string `yaml:"fields"`
+}
+
+// Transition moves project items which have been in the From status for longer than After into the To status.
+type Transition struct {
+	From string `yaml:"from"`
+	To   string `yaml:"to"`
+	// After is a duration, for example, `14d`, `2bd` or `36h`.
+	After string `yaml:"after"`
+	// Action is one of move, archive, delete, unarchive or convert. Defaults to move.
+	Action string `yaml:"action"`
+	// Repository is where convert creates the issues, either owner/name or name. Defaults to the repository.
+	Repository string `yaml:"repository"`
+}
+
+// Command is a named slash command which runs a sequence of existing commands.
+type Command struct {
+	// Name of the command, for example, `ready` which is then used as `/ready`.
+	Name string `yaml:"name"`
+	// Description is displayed in the output of `/help`.
+	Description string `yaml:"description"`
+	// Usage shows how to call the command, for example, `/ready status=<name>`.
+	Usage string `yaml:"usage"`
+	// Arguments documents the `key=value` arguments of the command.
+	Arguments []Argument `yaml:"arguments"`
+	// Examples are displayed by `/help <command>`.
+	Examples []string `yaml:"examples"`
+	// Role is the minimum repository permission required to run the command:
+	// read, triage, write, maintain or admin. Empty means anyone can run it.
+	Role string `yaml:"role"`
+	// Steps are executed in order. The first failing step stops the command.
+	Steps []Step `yaml:"steps"`
+}
+
+// Argument documents an argument of a custom command.
+type Argument struct {
+	Name        string `yaml:"name"`
+	Description string `yaml:"description"`
+	Required    bool   `yaml:"required"`
+}
+
+// Step runs an already registered command.
+type Step struct {
+	// Command is the name of a registered command, for example, `/status`.
+	Command string `yaml:"command"`
+	// Args are passed to the command. They are Go templates which can refer to `.Actor`,
+	// `.PullNumber` and the arguments of the custom command through `.Args.key`.
+	Args []string `yaml:"args"`
+}
+
+// Load reads the configuration file from the given path. An empty path returns an empty configuration.
+func Load(path string) (*Config, error) {
+	if path == "" {
+		return &Config{}, nil
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read configuration file: %w", err)
+	}
+
+	cfg := &Config{}
+	if err := yaml.Unmarshal(content, cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse configuration file %s: %w", path, err)
+	}
+
+	for _, cmd := range cfg.Commands {
+		if cmd.Name == "" {
+			return nil, fmt.Errorf("command without a name found in configuration file %s", path)
+		}
+
+		if len(cmd.Steps) == 0 {
+			return nil, fmt.Errorf("command %s does not define any steps", cmd.Name)
+		}
+
+		switch strings.ToLower(cmd.Role) {
+		case "", "read", "triage", "write", "maintain", "admin":
+		default:
+			return nil, fmt.Errorf("command %s defines unknown role %s", cmd.Name, cmd.Role)
+		}
+	}
+
+	for _, a := range cfg.Assignments {
+		if a.Project < 1 {
+			return nil, fmt.Errorf("assignment without a project number found in configuration file %s", path)
+		}
+	}
+
+	return cfg, nil
+}
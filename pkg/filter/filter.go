@@ -0,0 +1,256 @@
+// Package filter implements a subset of GitHub's project filter syntax, for example,
+// `status:"In Review" label:bug,feature -assignee:@me`.
+// https://docs.github.com/en/issues/planning-and-tracking-with-projects/customizing-views-in-your-project/filtering-projects
+package filter
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Me is replaced by the login of the authenticated user.
+const Me = "@me"
+
+const (
+	qualifierStatus   = "status"
+	qualifierLabel    = "label"
+	qualifierAssignee = "assignee"
+	qualifierAuthor   = "author"
+	qualifierIs       = "is"
+	qualifierNo       = "no"
+	qualifierHas      = "has"
+	// qualifierText matches the title; it's used for terms without a qualifier.
+	qualifierText = ""
+)
+
+// fields are the values of the no and has qualifiers.
+var fields = map[string]struct{}{
+	qualifierStatus:   {},
+	qualifierLabel:    {},
+	qualifierAssignee: {},
+}
+
+// states are the values of the is qualifier.
+var states = map[string]struct{}{
+	"open":     {},
+	"closed":   {},
+	"issue":    {},
+	"pr":       {},
+	"draft":    {},
+	"archived": {},
+}
+
+// term is a single qualifier of the filter. An item matches a term if it matches any of the values.
+type term struct {
+	qualifier string
+	values    []string
+	negated   bool
+}
+
+// Filter is a parsed project filter. All terms have to match.
+type Filter struct {
+	query string
+	terms []term
+}
+
+// Parse parses a project filter. An empty query returns nil, which matches every item.
+func Parse(query string) (*Filter, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, nil
+	}
+
+	tokens, err := tokenize(query)
+	if err != nil {
+		return nil, err
+	}
+
+	f := &Filter{query: query}
+
+	for _, token := range tokens {
+		t, err := parseTerm(token)
+		if err != nil {
+			return nil, err
+		}
+
+		f.terms = append(f.terms, t)
+	}
+
+	return f, nil
+}
+
+// String returns the query the filter was parsed from.
+func (f *Filter) String() string {
+	if f == nil {
+		return ""
+	}
+
+	return f.query
+}
+
+// UsesArchived returns whether any of the terms refers to archived items. The API hides archived items from
+// filters which don't.
+func (f *Filter) UsesArchived() bool {
+	if f == nil {
+		return false
+	}
+
+	for _, t := range f.terms {
+		if t.qualifier != qualifierIs {
+			continue
+		}
+
+		for _, v := range t.values {
+			if strings.EqualFold(v, "archived") {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// UsesMe returns whether any of the terms refers to the authenticated user.
+func (f *Filter) UsesMe() bool {
+	if f == nil {
+		return false
+	}
+
+	for _, t := range f.terms {
+		for _, v := range t.values {
+			if v == Me {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func parseTerm(token string) (term, error) {
+	var t term
+
+	if strings.HasPrefix(token, "-") && len(token) > 1 {
+		t.negated = true
+		token = token[1:]
+	}
+
+	if qualifier, value, ok := cutQualifier(token); ok {
+		t.qualifier = strings.ToLower(qualifier)
+		token = value
+	}
+
+	t.values = splitValues(token)
+	if len(t.values) == 0 {
+		return term{}, fmt.Errorf("qualifier %s requires a value", t.qualifier)
+	}
+
+	switch t.qualifier {
+	case qualifierStatus, qualifierLabel, qualifierAssignee, qualifierAuthor, qualifierText:
+	case qualifierIs:
+		if err := validate(t, states); err != nil {
+			return term{}, err
+		}
+	case qualifierNo, qualifierHas:
+		if err := validate(t, fields); err != nil {
+			return term{}, err
+		}
+	default:
+		return term{}, fmt.Errorf("unsupported qualifier %s", t.qualifier)
+	}
+
+	return t, nil
+}
+
+func validate(t term, allowed map[string]struct{}) error {
+	for _, v := range t.values {
+		if _, ok := allowed[strings.ToLower(v)]; !ok {
+			return fmt.Errorf("unsupported value %s for qualifier %s", v, t.qualifier)
+		}
+	}
+
+	return nil
+}
+
+// cutQualifier splits the token at the first colon outside of quotes.
+func cutQualifier(token string) (string, string, bool) {
+	quoted := false
+
+	for i, r := range token {
+		switch {
+		case r == '"':
+			quoted = !quoted
+		case r == ':' && !quoted:
+			return token[:i], token[i+1:], true
+		}
+	}
+
+	return "", token, false
+}
+
+// tokenize splits the query on whitespace outside of quotes.
+func tokenize(query string) ([]string, error) {
+	var (
+		tokens  []string
+		current strings.Builder
+		quoted  bool
+	)
+
+	for _, r := range query {
+		switch {
+		case r == '"':
+			quoted = !quoted
+
+			current.WriteRune(r)
+		case (r == ' ' || r == '\t' || r == '\n') && !quoted:
+			if current.Len() > 0 {
+				tokens = append(tokens, current.String())
+				current.Reset()
+			}
+		default:
+			current.WriteRune(r)
+		}
+	}
+
+	if quoted {
+		return nil, errors.New("unterminated quote in filter")
+	}
+
+	if current.Len() > 0 {
+		tokens = append(tokens, current.String())
+	}
+
+	return tokens, nil
+}
+
+// splitValues splits the value of a term on commas outside of quotes and removes the quotes.
+func splitValues(value string) []string {
+	var (
+		values  []string
+		current strings.Builder
+		quoted  bool
+	)
+
+	flush := func() {
+		if current.Len() > 0 {
+			values = append(values, current.String())
+			current.Reset()
+		}
+	}
+
+	for _, r := range value {
+		switch {
+		case r == '"':
+			quoted = !quoted
+		case r == ',' && !quoted:
+			flush()
+		default:
+			current.WriteRune(r)
+		}
+	}
+
+	flush()
+
+	return values
+}
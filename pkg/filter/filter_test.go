@@ -0,0 +1,75 @@
+package filter
+
+import (
+	"testing"
+
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skarlso/caretaker/pkg/client"
+)
+
+func TestParse(t *testing.T) {
+	f, err := Parse(`status:"In Review" label:bug,"good first issue" -assignee:@me flaky`)
+	require.NoError(t, err)
+
+	assert.Equal(t, []term{
+		{qualifier: "status", values: []string{"In Review"}},
+		{qualifier: "label", values: []string{"bug", "good first issue"}},
+		{qualifier: "assignee", values: []string{"@me"}, negated: true},
+		{qualifier: "", values: []string{"flaky"}},
+	}, f.terms)
+	assert.True(t, f.UsesMe())
+
+	for _, query := range []string{`status:"In Review`, `priority:high`, `is:merged`, `no:milestone`, `label:`} {
+		_, err := Parse(query)
+		assert.Error(t, err, query)
+	}
+
+	f, err = Parse("  ")
+	require.NoError(t, err)
+	assert.Nil(t, f)
+	assert.True(t, f.Match(client.ProjectV2ItemWithIssueContent{}, ""))
+}
+
+func TestFilter_Match(t *testing.T) {
+	issue := client.ProjectV2ItemWithIssueContent{Type: client.IssueType}
+	issue.FieldValueByName.ProjectV2SingleSelectField.Name = "In Review"
+	issue.Content.Issue.Title = "Fix flaky test"
+	issue.Content.Issue.Author.Login = "octocat"
+	issue.Content.Issue.Labels.Nodes = []struct{ Name githubv4.String }{{Name: "bug"}}
+	issue.Content.Issue.Assignees.Nodes = []struct {
+		ID    githubv4.ID
+		Login githubv4.String
+	}{{ID: "1", Login: "me"}}
+
+	draft := client.ProjectV2ItemWithIssueContent{Type: client.DraftIssueType, IsArchived: true}
+	draft.Content.DraftIssue.Title = "Idea"
+
+	tests := []struct {
+		query string
+		item  client.ProjectV2ItemWithIssueContent
+		want  bool
+	}{
+		{query: `status:"in review" label:bug`, item: issue, want: true},
+		{query: `status:Done,"In Review"`, item: issue, want: true},
+		{query: `status:Done`, item: issue, want: false},
+		{query: `-assignee:@me`, item: issue, want: false},
+		{query: `assignee:@me author:octocat`, item: issue, want: true},
+		{query: `flaky is:issue is:open`, item: issue, want: true},
+		{query: `no:label`, item: issue, want: false},
+		{query: `no:status no:assignee is:draft is:archived`, item: draft, want: true},
+		{query: `has:label`, item: draft, want: false},
+		{query: `-is:draft`, item: draft, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			f, err := Parse(tt.query)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.want, f.Match(tt.item, "me"))
+		})
+	}
+}
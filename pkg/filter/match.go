@@ -0,0 +1,160 @@
+package filter
+
+import (
+	"strings"
+
+	"github.com/shurcooL/githubv4"
+
+	"github.com/skarlso/caretaker/pkg/client"
+)
+
+// item collects the values of a project item the filter is evaluated against.
+type item struct {
+	title     string
+	status    string
+	labels    []string
+	assignees []string
+	author    string
+	kind      string
+	closed    bool
+	archived  bool
+}
+
+func newItem(i client.ProjectV2ItemWithIssueContent) item {
+	result := item{
+		status:   string(i.FieldValueByName.ProjectV2SingleSelectField.Name),
+		archived: bool(i.IsArchived),
+	}
+
+	switch i.Type {
+	case client.IssueType:
+		issue := i.Content.Issue
+		result.kind = "issue"
+		result.title = string(issue.Title)
+		result.author = string(issue.Author.Login)
+		result.closed = bool(issue.Closed)
+		result.labels = labelNames(issue.Labels.Nodes)
+		result.assignees = assigneeLogins(issue.Assignees)
+	case client.PullRequestType:
+		pr := i.Content.PullRequest
+		result.kind = "pr"
+		result.title = string(pr.Title)
+		result.author = string(pr.Author.Login)
+		result.closed = bool(pr.Closed)
+		result.labels = labelNames(pr.Labels.Nodes)
+		result.assignees = assigneeLogins(pr.Assignees)
+	case client.DraftIssueType:
+		result.kind = "draft"
+		result.title = string(i.Content.DraftIssue.Title)
+	}
+
+	return result
+}
+
+func labelNames(nodes []struct{ Name githubv4.String }) []string {
+	result := make([]string, 0, len(nodes))
+	for _, n := range nodes {
+		result = append(result, string(n.Name))
+	}
+
+	return result
+}
+
+func assigneeLogins(assignees client.Assignees) []string {
+	result := make([]string, 0, len(assignees.Nodes))
+	for _, n := range assignees.Nodes {
+		result = append(result, string(n.Login))
+	}
+
+	return result
+}
+
+// Match returns whether the project item matches all terms of the filter. me is the login
+// of the authenticated user which replaces @me. A nil filter matches every item.
+func (f *Filter) Match(i client.ProjectV2ItemWithIssueContent, me string) bool {
+	if f == nil {
+		return true
+	}
+
+	it := newItem(i)
+
+	for _, t := range f.terms {
+		if t.match(it, me) == t.negated {
+			return false
+		}
+	}
+
+	return true
+}
+
+// match returns whether any of the values of the term matches the item.
+func (t term) match(it item, me string) bool {
+	for _, v := range t.values {
+		if v == Me {
+			v = me
+		}
+
+		if t.matchValue(it, v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (t term) matchValue(it item, value string) bool {
+	switch t.qualifier {
+	case qualifierStatus:
+		return strings.EqualFold(it.status, value)
+	case qualifierLabel:
+		return contains(it.labels, value)
+	case qualifierAssignee:
+		return contains(it.assignees, value)
+	case qualifierAuthor:
+		return strings.EqualFold(it.author, value)
+	case qualifierIs:
+		return it.is(strings.ToLower(value))
+	case qualifierNo:
+		return !it.has(strings.ToLower(value))
+	case qualifierHas:
+		return it.has(strings.ToLower(value))
+	default:
+		return strings.Contains(strings.ToLower(it.title), strings.ToLower(value))
+	}
+}
+
+func (it item) is(state string) bool {
+	switch state {
+	case "open":
+		return !it.closed
+	case "closed":
+		return it.closed
+	case "archived":
+		return it.archived
+	default:
+		return it.kind == state
+	}
+}
+
+func (it item) has(field string) bool {
+	switch field {
+	case qualifierStatus:
+		return it.status != ""
+	case qualifierLabel:
+		return len(it.labels) > 0
+	case qualifierAssignee:
+		return len(it.assignees) > 0
+	}
+
+	return false
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+
+	return false
+}
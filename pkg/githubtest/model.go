@@ -0,0 +1,165 @@
+package githubtest
+
+import (
+	"strings"
+	"time"
+)
+
+// Account is a user or an organization.
+type Account struct {
+	ID           string
+	Login        string
+	Organization bool
+	Teams        []*Team
+}
+
+// Team is a team of an organization.
+type Team struct {
+	ID   string
+	Slug string
+}
+
+// Repository has labels, issues and pull requests, which share their numbers.
+type Repository struct {
+	ID    string
+	Owner *Account
+	Name  string
+	// Collaborators maps the logins of the collaborators to their permission, for example, WRITE.
+	Collaborators map[string]string
+	Labels        []*Label
+	Issues        []*Issue
+	PullRequests  []*PullRequest
+	Topics        []string
+	IsArchived    bool
+
+	server *Server
+}
+
+// Label is a label of a repository.
+type Label struct {
+	ID    string
+	Name  string
+	Color string
+}
+
+// Issue is an issue of a repository.
+type Issue struct {
+	ID        string
+	Number    int
+	Title     string
+	Closed    bool
+	UpdatedAt time.Time
+	Author    *Account
+	Labels    []*Label
+	Assignees []*Account
+
+	repository *Repository
+}
+
+// HasLabel returns whether the issue has the label, ignoring case.
+func (i *Issue) HasLabel(name string) bool {
+	return findLabel(i.Labels, name) != nil
+}
+
+// Review is a review of a pull request.
+type Review struct {
+	State       string
+	SubmittedAt time.Time
+}
+
+// PullRequest is a pull request of a repository. The fields it shares with issues are embedded.
+type PullRequest struct {
+	Issue
+
+	Body           string
+	IsDraft        bool
+	BaseRefName    string
+	HeadRefName    string
+	ReviewDecision string
+	// ReviewRequests are the IDs of the users and teams a review has been requested from.
+	ReviewRequests []string
+	Reviews        []Review
+	ClosingIssues  []*Issue
+	Comments       []*Comment
+}
+
+// Comment is a comment of a pull request.
+type Comment struct {
+	ID     string
+	Body   string
+	Author *Account
+	// Reactions maps the reaction contents, for example, THUMBS_UP, to the logins which reacted.
+	Reactions map[string][]string
+}
+
+// DraftIssue is the content of a project item which hasn't been converted into an issue yet.
+type DraftIssue struct {
+	ID        string
+	Title     string
+	UpdatedAt time.Time
+}
+
+// Project is a ProjectV2 board of a user or organization with a Status field.
+type Project struct {
+	ID     string
+	Number int
+	Title  string
+	Owner  *Account
+	// StatusFieldID is the ID of the single select Status field.
+	StatusFieldID string
+	Statuses      []*StatusOption
+	// Fields are the fields besides Status.
+	Fields []*Field
+	Items  []*Item
+
+	server *Server
+}
+
+// StatusOption is an option of the Status field.
+type StatusOption struct {
+	ID   string
+	Name string
+}
+
+// Field is a field of a project besides Status. The data type is TEXT, NUMBER, DATE, SINGLE_SELECT or
+// ITERATION; options are only used by single select fields.
+type Field struct {
+	ID       string
+	Name     string
+	DataType string
+	Options  []*StatusOption
+}
+
+// Item is an item of a project. The content is an *Issue, a *PullRequest or a *DraftIssue.
+type Item struct {
+	ID              string
+	Content         any
+	Status          string
+	StatusUpdatedAt time.Time
+	IsArchived      bool
+	UpdatedAt       time.Time
+	// Values maps the names of the fields besides Status to their values. Single select values are the
+	// names of the options and dates are formatted as YYYY-MM-DD.
+	Values map[string]string
+
+	project *Project
+}
+
+// SetStatus sets the Status of the item.
+func (i *Item) SetStatus(status string) *Item {
+	i.Status = status
+	i.StatusUpdatedAt = i.project.server.Now()
+	i.UpdatedAt = i.StatusUpdatedAt
+
+	return i
+}
+
+func findLabel(labels []*Label, name string) *Label {
+	for _, l := range labels {
+		if strings.EqualFold(l.Name, name) {
+			return l
+		}
+	}
+
+	return nil
+}
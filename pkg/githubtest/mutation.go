@@ -0,0 +1,507 @@
+package githubtest
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/shurcooL/githubv4"
+
+	"github.com/skarlso/caretaker/pkg/client"
+)
+
+// mutationField executes a mutation and returns its payload.
+func (s *Server) mutationField(name string, args map[string]any) (any, error) {
+	input, _ := args["input"].(map[string]any)
+	if input == nil {
+		return nil, apiErrorf("Argument 'input' on Field '%s' is missing", name)
+	}
+
+	s.mutations = append(s.mutations, name)
+
+	switch name {
+	case "addLabelsToLabelable", "removeLabelsFromLabelable":
+		return s.updateLabels(input, name == "addLabelsToLabelable")
+	case "createLabel":
+		return s.createLabel(input)
+	case "addAssigneesToAssignable", "removeAssigneesFromAssignable":
+		return s.updateAssignees(input, name == "addAssigneesToAssignable")
+	case "addComment":
+		return s.addComment(input)
+	case "updateIssueComment":
+		return s.updateComment(input)
+	case "addReaction":
+		return s.addReaction(input)
+	case "updatePullRequest":
+		return s.updatePullRequest(input)
+	case "requestReviews":
+		return s.requestReviews(input)
+	case "addProjectV2ItemById":
+		return s.addProjectItem(input)
+	case "updateProjectV2ItemFieldValue":
+		return s.updateItemFieldValue(input)
+	case "clearProjectV2ItemFieldValue":
+		return s.clearItemFieldValue(input)
+	case "archiveProjectV2Item", "unarchiveProjectV2Item":
+		return s.archiveItem(input, name == "archiveProjectV2Item")
+	case "deleteProjectV2Item":
+		return s.deleteItem(input)
+	case "convertProjectV2DraftIssueItemToIssue":
+		return s.convertDraftIssue(input)
+	}
+
+	return nil, apiErrorf("Field '%s' doesn't exist on type 'Mutation'", name)
+}
+
+// lookup returns the node with the ID in the input field. It fails if the node doesn't exist or
+// has the wrong type.
+func lookup[T any](s *Server, input map[string]any, field string) (T, error) {
+	id := stringArg(input, field)
+
+	node, ok := s.node(id).(T)
+	if !ok {
+		return node, notFound("Could not resolve to a node with the global id of '%s'", id)
+	}
+
+	return node, nil
+}
+
+// lookupAll returns the nodes with the IDs in the input field.
+func lookupAll[T any](s *Server, input map[string]any, field string) ([]T, error) {
+	ids, _ := input[field].([]any)
+	result := make([]T, 0, len(ids))
+
+	for _, id := range ids {
+		node, err := lookup[T](s, map[string]any{field: id}, field)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, node)
+	}
+
+	return result, nil
+}
+
+// issueOf returns the fields an issue or pull request share.
+func issueOf(content any) *Issue {
+	switch content := content.(type) {
+	case *Issue:
+		return content
+	case *PullRequest:
+		return &content.Issue
+	default:
+		return nil
+	}
+}
+
+func (s *Server) labelable(input map[string]any, field string) (any, *Issue, error) {
+	id := stringArg(input, field)
+	content := s.node(id)
+
+	issue := issueOf(content)
+	if issue == nil {
+		return nil, nil, notFound("Could not resolve to a node with the global id of '%s'", id)
+	}
+
+	return content, issue, nil
+}
+
+func (s *Server) updateLabels(input map[string]any, add bool) (any, error) {
+	content, issue, err := s.labelable(input, "labelableId")
+	if err != nil {
+		return nil, err
+	}
+
+	labels, err := lookupAll[*Label](s, input, "labelIds")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, l := range labels {
+		if findLabel(issue.repository.Labels, l.Name) != l {
+			return nil, fmt.Errorf("label %s does not belong to repository %s", l.ID, issue.repository.Name)
+		}
+
+		if add && !issue.HasLabel(l.Name) {
+			issue.Labels = append(issue.Labels, l)
+		}
+
+		if !add {
+			issue.Labels = remove(issue.Labels, l)
+		}
+	}
+
+	issue.UpdatedAt = s.Now()
+
+	return object{"labelable": content, "clientMutationId": nil}, nil
+}
+
+func remove[T comparable](list []T, value T) []T {
+	result := list[:0]
+
+	for _, v := range list {
+		if v != value {
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
+
+func (s *Server) createLabel(input map[string]any) (any, error) {
+	r, err := lookup[*Repository](s, input, "repositoryId")
+	if err != nil {
+		return nil, err
+	}
+
+	name := stringArg(input, "name")
+	if r.Label(name) != nil {
+		return nil, apiErrorf("Name has already been taken")
+	}
+
+	l := r.AddLabel(name)
+	l.Color = stringArg(input, "color")
+
+	return object{"label": l}, nil
+}
+
+func (s *Server) updateAssignees(input map[string]any, add bool) (any, error) {
+	content, issue, err := s.labelable(input, "assignableId")
+	if err != nil {
+		return nil, err
+	}
+
+	assignees, err := lookupAll[*Account](s, input, "assigneeIds")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, a := range assignees {
+		issue.Assignees = remove(issue.Assignees, a)
+
+		if add {
+			issue.Assignees = append(issue.Assignees, a)
+		}
+	}
+
+	issue.UpdatedAt = s.Now()
+
+	return object{"assignable": content, "clientMutationId": nil}, nil
+}
+
+func (s *Server) addComment(input map[string]any) (any, error) {
+	pr, err := lookup[*PullRequest](s, input, "subjectId")
+	if err != nil {
+		return nil, err
+	}
+
+	c := pr.AddComment(s.Viewer, stringArg(input, "body"))
+	pr.UpdatedAt = s.Now()
+
+	return object{"subject": pr, "commentEdge": object{"node": c}}, nil
+}
+
+func (s *Server) updateComment(input map[string]any) (any, error) {
+	c, err := lookup[*Comment](s, input, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	c.Body = stringArg(input, "body")
+
+	return object{"issueComment": c}, nil
+}
+
+func (s *Server) addReaction(input map[string]any) (any, error) {
+	c, err := lookup[*Comment](s, input, "subjectId")
+	if err != nil {
+		return nil, err
+	}
+
+	content := stringArg(input, "content")
+	c.Reactions[content] = append(remove(c.Reactions[content], s.Viewer), s.Viewer)
+
+	return object{"subject": c, "reaction": object{"content": content}}, nil
+}
+
+func (s *Server) updatePullRequest(input map[string]any) (any, error) {
+	pr, err := lookup[*PullRequest](s, input, "pullRequestId")
+	if err != nil {
+		return nil, err
+	}
+
+	if body, ok := input["body"].(string); ok {
+		pr.Body = body
+	}
+
+	pr.UpdatedAt = s.Now()
+
+	return object{"pullRequest": pr}, nil
+}
+
+func (s *Server) requestReviews(input map[string]any) (any, error) {
+	pr, err := lookup[*PullRequest](s, input, "pullRequestId")
+	if err != nil {
+		return nil, err
+	}
+
+	users, err := lookupAll[*Account](s, input, "userIds")
+	if err != nil {
+		return nil, err
+	}
+
+	teams, err := lookupAll[*Team](s, input, "teamIds")
+	if err != nil {
+		return nil, err
+	}
+
+	if union, _ := input["union"].(bool); !union {
+		pr.ReviewRequests = nil
+	}
+
+	for _, u := range users {
+		pr.ReviewRequests = append(remove(pr.ReviewRequests, u.ID), u.ID)
+	}
+
+	for _, t := range teams {
+		pr.ReviewRequests = append(remove(pr.ReviewRequests, t.ID), t.ID)
+	}
+
+	return object{"pullRequest": pr}, nil
+}
+
+func (s *Server) addProjectItem(input map[string]any) (any, error) {
+	p, err := lookup[*Project](s, input, "projectId")
+	if err != nil {
+		return nil, err
+	}
+
+	id := stringArg(input, "contentId")
+
+	content := s.node(id)
+	if issueOf(content) == nil {
+		return nil, notFound("Could not resolve to a node with the global id of '%s'", id)
+	}
+
+	// Adding an item which is already in the project returns the existing item like GitHub does.
+	return object{"item": p.AddItem(content)}, nil
+}
+
+func (s *Server) projectItem(input map[string]any) (*Project, *Item, error) {
+	p, err := lookup[*Project](s, input, "projectId")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	i, err := lookup[*Item](s, input, "itemId")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if i.project != p {
+		return nil, nil, apiErrorf("The item %s does not belong to the project %s", i.ID, p.ID)
+	}
+
+	return p, i, nil
+}
+
+func (s *Server) updateItemFieldValue(input map[string]any) (any, error) {
+	p, i, err := s.projectItem(input)
+	if err != nil {
+		return nil, err
+	}
+
+	value, _ := input["value"].(map[string]any)
+
+	fieldID := stringArg(input, "fieldId")
+	if fieldID != p.StatusFieldID {
+		for _, f := range p.Fields {
+			if f.ID == fieldID {
+				return s.updateFieldValue(i, f, value)
+			}
+		}
+
+		return nil, notFound("Could not resolve to a node with the global id of '%s'", fieldID)
+	}
+
+	optionID := stringArg(value, "singleSelectOptionId")
+
+	option := p.status(optionID)
+	if option == nil {
+		return nil, apiErrorf("The single select option Id does not belong to the field")
+	}
+
+	i.SetStatus(option.Name)
+
+	return object{"projectV2Item": i}, nil
+}
+
+func (s *Server) clearItemFieldValue(input map[string]any) (any, error) {
+	p, i, err := s.projectItem(input)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldID := stringArg(input, "fieldId")
+	if fieldID == p.StatusFieldID {
+		i.SetStatus("")
+
+		return object{"projectV2Item": i}, nil
+	}
+
+	for _, f := range p.Fields {
+		if f.ID == fieldID {
+			delete(i.Values, f.Name)
+			i.UpdatedAt = s.Now()
+
+			return object{"projectV2Item": i}, nil
+		}
+	}
+
+	return nil, notFound("Could not resolve to a node with the global id of '%s'", fieldID)
+}
+
+// updateFieldValue sets the value of a field besides Status. The value has to match the data type of the field.
+func (s *Server) updateFieldValue(i *Item, f *Field, value map[string]any) (any, error) {
+	var v string
+
+	switch f.DataType {
+	case "TEXT":
+		v = stringArg(value, "text")
+	case "NUMBER":
+		number, ok := value["number"].(float64)
+		if !ok {
+			return nil, apiErrorf("The number value is missing for the field %s", f.Name)
+		}
+
+		v = strconv.FormatFloat(number, 'f', -1, 64)
+	case "DATE":
+		date := stringArg(value, "date")
+		if len(date) < len("2006-01-02") {
+			return nil, apiErrorf("The date value is missing for the field %s", f.Name)
+		}
+
+		v = date[:len("2006-01-02")]
+	case "SINGLE_SELECT":
+		optionID := stringArg(value, "singleSelectOptionId")
+		for _, o := range f.Options {
+			if o.ID == optionID {
+				v = o.Name
+			}
+		}
+
+		if v == "" {
+			return nil, apiErrorf("The single select option Id does not belong to the field")
+		}
+	default:
+		return nil, apiErrorf("The field %s of type %s can't be updated", f.Name, f.DataType)
+	}
+
+	if i.Values == nil {
+		i.Values = make(map[string]string)
+	}
+
+	i.Values[f.Name] = v
+	i.UpdatedAt = s.Now()
+
+	return object{"projectV2Item": i}, nil
+}
+
+func (s *Server) archiveItem(input map[string]any, archived bool) (any, error) {
+	_, i, err := s.projectItem(input)
+	if err != nil {
+		return nil, err
+	}
+
+	i.IsArchived = archived
+	i.UpdatedAt = s.Now()
+
+	return object{"item": i}, nil
+}
+
+func (s *Server) deleteItem(input map[string]any) (any, error) {
+	p, i, err := s.projectItem(input)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Items = remove(p.Items, i)
+
+	return object{"deletedItemId": i.ID}, nil
+}
+
+func (s *Server) convertDraftIssue(input map[string]any) (any, error) {
+	i, err := lookup[*Item](s, input, "itemId")
+	if err != nil {
+		return nil, err
+	}
+
+	draft, ok := i.Content.(*DraftIssue)
+	if !ok {
+		return nil, apiErrorf("The item %s is not a draft issue", i.ID)
+	}
+
+	r, err := lookup[*Repository](s, input, "repositoryId")
+	if err != nil {
+		return nil, err
+	}
+
+	i.Content = r.AddIssue(draft.Title)
+	i.UpdatedAt = s.Now()
+
+	return object{"item": i}, nil
+}
+
+// clientItem converts an item into the type of the client, so the filter package can evaluate
+// the query argument of the items of a project.
+func (s *Server) clientItem(i *Item) client.ProjectV2ItemWithIssueContent {
+	result := client.ProjectV2ItemWithIssueContent{
+		ID:         githubv4.String(i.ID),
+		Type:       githubv4.String(itemType(i.Content)),
+		IsArchived: githubv4.Boolean(i.IsArchived),
+	}
+	result.FieldValueByName.ProjectV2SingleSelectField.Name = githubv4.String(i.Status)
+
+	issue := issueOf(i.Content)
+	if issue == nil {
+		if draft, ok := i.Content.(*DraftIssue); ok {
+			result.Content.DraftIssue.Title = githubv4.String(draft.Title)
+		}
+
+		return result
+	}
+
+	content := client.Issue{
+		Title:  githubv4.String(issue.Title),
+		Closed: githubv4.Boolean(issue.Closed),
+	}
+
+	if issue.Author != nil {
+		content.Author.Login = githubv4.String(issue.Author.Login)
+	}
+
+	for _, l := range issue.Labels {
+		content.Labels.Nodes = append(content.Labels.Nodes, struct{ Name githubv4.String }{Name: githubv4.String(l.Name)})
+	}
+
+	for _, a := range issue.Assignees {
+		content.Assignees.Nodes = append(content.Assignees.Nodes, struct {
+			ID    githubv4.ID
+			Login githubv4.String
+		}{ID: a.ID, Login: githubv4.String(a.Login)})
+	}
+
+	if _, ok := i.Content.(*PullRequest); ok {
+		result.Content.PullRequest.Title = content.Title
+		result.Content.PullRequest.Closed = content.Closed
+		result.Content.PullRequest.Author = content.Author
+		result.Content.PullRequest.Labels = content.Labels
+		result.Content.PullRequest.Assignees = content.Assignees
+
+		return result
+	}
+
+	result.Content.Issue = content
+
+	return result
+}
@@ -0,0 +1,356 @@
+package githubtest
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// operation is a parsed GraphQL document with a single query or mutation.
+type operation struct {
+	mutation   bool
+	selections []selection
+}
+
+// selection is a field or an inline fragment if typeCondition is set.
+type selection struct {
+	alias         string
+	name          string
+	arguments     map[string]value
+	typeCondition string
+	selections    []selection
+}
+
+// key is the name of the field in the response.
+func (s selection) key() string {
+	if s.alias != "" {
+		return s.alias
+	}
+
+	return s.name
+}
+
+// value is an argument value, either a literal or a reference to a variable.
+type value struct {
+	variable string
+	literal  any
+}
+
+// resolve returns the value of the argument with the variables substituted.
+func (v value) resolve(variables map[string]any) any {
+	if v.variable != "" {
+		return variables[v.variable]
+	}
+
+	if list, ok := v.literal.([]value); ok {
+		result := make([]any, 0, len(list))
+		for _, item := range list {
+			result = append(result, item.resolve(variables))
+		}
+
+		return result
+	}
+
+	return v.literal
+}
+
+// parser parses the subset of GraphQL the GitHub client library generates from struct tags:
+// a single anonymous operation with variable definitions, fields with aliases and arguments,
+// and inline fragments.
+type parser struct {
+	input string
+	pos   int
+}
+
+func parse(document string) (operation, error) {
+	p := &parser{input: document}
+
+	var op operation
+
+	switch name := p.name(); name {
+	case "query":
+	case "mutation":
+		op.mutation = true
+	case "":
+		if p.peek() != '{' {
+			return op, p.errorf("expected an operation")
+		}
+	default:
+		return op, p.errorf("unsupported operation %q", name)
+	}
+
+	// The variable definitions are skipped; the variables are used as they have been sent.
+	if p.peek() == '(' {
+		if err := p.skipParentheses(); err != nil {
+			return op, err
+		}
+	}
+
+	selections, err := p.selectionSet()
+	if err != nil {
+		return op, err
+	}
+
+	op.selections = selections
+
+	if p.skipSpace(); p.pos != len(p.input) {
+		return op, p.errorf("unexpected %q after the operation", p.input[p.pos:])
+	}
+
+	return op, nil
+}
+
+func (p *parser) errorf(format string, args ...any) error {
+	return fmt.Errorf("parse error at %d: %s", p.pos, fmt.Sprintf(format, args...))
+}
+
+// skipSpace skips white space and commas, which are insignificant in GraphQL.
+func (p *parser) skipSpace() {
+	for p.pos < len(p.input) {
+		c := p.input[p.pos]
+		if c != ',' && !unicode.IsSpace(rune(c)) {
+			return
+		}
+
+		p.pos++
+	}
+}
+
+func (p *parser) peek() byte {
+	p.skipSpace()
+
+	if p.pos >= len(p.input) {
+		return 0
+	}
+
+	return p.input[p.pos]
+}
+
+func (p *parser) expect(c byte) error {
+	if p.peek() != c {
+		return p.errorf("expected %q", c)
+	}
+
+	p.pos++
+
+	return nil
+}
+
+func (p *parser) name() string {
+	p.skipSpace()
+
+	start := p.pos
+	for p.pos < len(p.input) {
+		c := rune(p.input[p.pos])
+		if c != '_' && !unicode.IsLetter(c) && !unicode.IsDigit(c) {
+			break
+		}
+
+		p.pos++
+	}
+
+	return p.input[start:p.pos]
+}
+
+func (p *parser) skipParentheses() error {
+	depth := 0
+
+	for ; p.pos < len(p.input); p.pos++ {
+		switch p.input[p.pos] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+
+			if depth == 0 {
+				p.pos++
+
+				return nil
+			}
+		}
+	}
+
+	return p.errorf("unterminated variable definitions")
+}
+
+func (p *parser) selectionSet() ([]selection, error) {
+	if err := p.expect('{'); err != nil {
+		return nil, err
+	}
+
+	var result []selection
+
+	for p.peek() != '}' {
+		if p.pos >= len(p.input) {
+			return nil, p.errorf("unterminated selection set")
+		}
+
+		s, err := p.selection()
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, s)
+	}
+
+	p.pos++
+
+	return result, nil
+}
+
+func (p *parser) selection() (selection, error) {
+	var s selection
+
+	if strings.HasPrefix(p.input[p.pos:], "...") {
+		p.pos += len("...")
+
+		if on := p.name(); on != "on" {
+			return s, p.errorf("only inline fragments are supported")
+		}
+
+		s.typeCondition = p.name()
+
+		selections, err := p.selectionSet()
+		s.selections = selections
+
+		return s, err
+	}
+
+	s.name = p.name()
+	if s.name == "" {
+		return s, p.errorf("expected a field")
+	}
+
+	if p.peek() == ':' {
+		p.pos++
+		s.alias, s.name = s.name, p.name()
+	}
+
+	if p.peek() == '(' {
+		arguments, err := p.arguments()
+		if err != nil {
+			return s, err
+		}
+
+		s.arguments = arguments
+	}
+
+	if p.peek() == '{' {
+		selections, err := p.selectionSet()
+		if err != nil {
+			return s, err
+		}
+
+		s.selections = selections
+	}
+
+	return s, nil
+}
+
+func (p *parser) arguments() (map[string]value, error) {
+	p.pos++
+
+	result := map[string]value{}
+
+	for p.peek() != ')' {
+		name := p.name()
+		if name == "" {
+			return nil, p.errorf("expected an argument")
+		}
+
+		if err := p.expect(':'); err != nil {
+			return nil, err
+		}
+
+		v, err := p.value()
+		if err != nil {
+			return nil, err
+		}
+
+		result[name] = v
+	}
+
+	p.pos++
+
+	return result, nil
+}
+
+func (p *parser) value() (value, error) {
+	switch c := p.peek(); {
+	case c == '$':
+		p.pos++
+
+		return value{variable: p.name()}, nil
+	case c == '"':
+		return p.stringValue()
+	case c == '[':
+		p.pos++
+
+		var list []value
+
+		for p.peek() != ']' {
+			v, err := p.value()
+			if err != nil {
+				return value{}, err
+			}
+
+			list = append(list, v)
+		}
+
+		p.pos++
+
+		return value{literal: list}, nil
+	case c == '-' || (c >= '0' && c <= '9'):
+		start := p.pos
+		p.pos++
+
+		for p.pos < len(p.input) && strings.IndexByte("0123456789.eE+-", p.input[p.pos]) >= 0 {
+			p.pos++
+		}
+
+		n, err := strconv.ParseFloat(p.input[start:p.pos], 64)
+		if err != nil {
+			return value{}, p.errorf("invalid number: %s", err)
+		}
+
+		return value{literal: n}, nil
+	default:
+		switch name := p.name(); name {
+		case "":
+			return value{}, p.errorf("expected a value")
+		case "true", "false":
+			return value{literal: name == "true"}, nil
+		case "null":
+			return value{}, nil
+		default:
+			// enum values are passed on as strings, like in the variables
+			return value{literal: name}, nil
+		}
+	}
+}
+
+func (p *parser) stringValue() (value, error) {
+	start := p.pos
+	p.pos++
+
+	for p.pos < len(p.input) {
+		switch p.input[p.pos] {
+		case '\\':
+			p.pos += 2
+		case '"':
+			p.pos++
+
+			s, err := strconv.Unquote(p.input[start:p.pos])
+			if err != nil {
+				return value{}, p.errorf("invalid string: %s", err)
+			}
+
+			return value{literal: s}, nil
+		default:
+			p.pos++
+		}
+	}
+
+	return value{}, p.errorf("unterminated string")
+}
@@ -0,0 +1,907 @@
+package githubtest
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/skarlso/caretaker/pkg/filter"
+)
+
+type (
+	queryRoot    struct{}
+	mutationRoot struct{}
+	// object is a plain object, like a mutation payload. The type name is the value of __typename.
+	object map[string]any
+	// statusField is the Status field of a project.
+	statusField struct{ project *Project }
+	// statusValue is the value of the Status field of an item.
+	statusValue struct{ item *Item }
+)
+
+// connection is a page of a list of nodes.
+type connection struct {
+	nodes      []any
+	totalCount int
+	endCursor  string
+	hasNext    bool
+}
+
+// apiError is an error with the message and type GitHub would report.
+type apiError struct {
+	message   string
+	errorType string
+}
+
+func (e *apiError) Error() string {
+	return e.message
+}
+
+func apiErrorf(format string, args ...any) error {
+	return &apiError{message: fmt.Sprintf(format, args...)}
+}
+
+func notFound(format string, args ...any) error {
+	return &apiError{message: fmt.Sprintf(format, args...), errorType: "NOT_FOUND"}
+}
+
+// executor resolves the selections of a single request.
+type executor struct {
+	server    *Server
+	variables map[string]any
+	errors    []graphQLError
+}
+
+func (e *executor) selectionSet(obj any, selections []selection, path []any) map[string]any {
+	result := map[string]any{}
+	typeName := e.server.typeName(obj)
+
+	for _, sel := range selections {
+		if sel.typeCondition != "" {
+			if sel.typeCondition == typeName {
+				merge(result, e.selectionSet(obj, sel.selections, path))
+			}
+
+			continue
+		}
+
+		fieldPath := append(append([]any(nil), path...), sel.key())
+
+		if sel.name == "__typename" {
+			result[sel.key()] = typeName
+
+			continue
+		}
+
+		args := make(map[string]any, len(sel.arguments))
+		for name, v := range sel.arguments {
+			args[name] = v.resolve(e.variables)
+		}
+
+		v, err := e.server.field(obj, sel.name, args)
+		if err != nil {
+			var errorType string
+
+			var apiErr *apiError
+			if errors.As(err, &apiErr) {
+				errorType = apiErr.errorType
+			}
+
+			e.errors = append(e.errors, graphQLError{Message: err.Error(), Type: errorType, Path: fieldPath})
+			result[sel.key()] = nil
+
+			continue
+		}
+
+		result[sel.key()] = e.complete(v, sel.selections, fieldPath)
+	}
+
+	return result
+}
+
+// merge merges the fields of an inline fragment into the fields selected already.
+func merge(dst, src map[string]any) {
+	for k, v := range src {
+		existing, ok := dst[k].(map[string]any)
+		if nested, isMap := v.(map[string]any); ok && isMap {
+			merge(existing, nested)
+
+			continue
+		}
+
+		dst[k] = v
+	}
+}
+
+func (e *executor) complete(v any, selections []selection, path []any) any {
+	if v == nil {
+		return nil
+	}
+
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.Pointer && rv.IsNil() {
+		return nil
+	}
+
+	switch v := v.(type) {
+	case string, bool, int, float64:
+		return v
+	case time.Time:
+		if v.IsZero() {
+			return nil
+		}
+
+		return v.UTC().Format(time.RFC3339)
+	case []any:
+		result := make([]any, 0, len(v))
+		for i, item := range v {
+			result = append(result, e.complete(item, selections, append(path, i)))
+		}
+
+		return result
+	default:
+		return e.selectionSet(v, selections, path)
+	}
+}
+
+func (s *Server) typeName(obj any) string {
+	switch obj := obj.(type) {
+	case queryRoot:
+		return "Query"
+	case mutationRoot:
+		return "Mutation"
+	case *Account:
+		if obj.Organization {
+			return "Organization"
+		}
+
+		return "User"
+	case *Team:
+		return "Team"
+	case *Repository:
+		return "Repository"
+	case *Label:
+		return "Label"
+	case *Issue:
+		return "Issue"
+	case *PullRequest:
+		return "PullRequest"
+	case *Comment:
+		return "IssueComment"
+	case *DraftIssue:
+		return "DraftIssue"
+	case *Project:
+		return "ProjectV2"
+	case *Item:
+		return "ProjectV2Item"
+	case statusField:
+		return "ProjectV2SingleSelectField"
+	case *Field:
+		switch obj.DataType {
+		case "SINGLE_SELECT":
+			return "ProjectV2SingleSelectField"
+		case "ITERATION":
+			return "ProjectV2IterationField"
+		default:
+			return "ProjectV2Field"
+		}
+	case statusValue:
+		return "ProjectV2ItemFieldSingleSelectValue"
+	case connection:
+		return "Connection"
+	case object:
+		typeName, _ := obj["__typename"].(string)
+
+		return typeName
+	default:
+		return ""
+	}
+}
+
+func (s *Server) field(obj any, name string, args map[string]any) (any, error) {
+	switch obj := obj.(type) {
+	case queryRoot:
+		return s.queryField(name, args)
+	case mutationRoot:
+		return s.mutationField(name, args)
+	case *Account:
+		return s.accountField(obj, name, args)
+	case *Team:
+		return idField(obj.ID, "Team", name)
+	case *Repository:
+		return s.repositoryField(obj, name, args)
+	case *Label:
+		switch name {
+		case "id":
+			return obj.ID, nil
+		case "name":
+			return obj.Name, nil
+		case "color":
+			return obj.Color, nil
+		}
+	case *Issue:
+		return s.contentField(obj, obj, name, args)
+	case *PullRequest:
+		return s.pullRequestField(obj, name, args)
+	case *Comment:
+		return s.commentField(obj, name)
+	case *DraftIssue:
+		return s.draftIssueField(obj, name)
+	case *Project:
+		return s.projectField(obj, name, args)
+	case *Item:
+		return s.itemField(obj, name, args)
+	case statusField:
+		return statusFieldValue(obj, name)
+	case *Field:
+		return s.fieldField(obj, name)
+	case statusValue:
+		return statusValueField(obj, name)
+	case connection:
+		return connectionField(obj, name)
+	case object:
+		if v, ok := obj[name]; ok {
+			return v, nil
+		}
+	}
+
+	return nil, apiErrorf("Field '%s' doesn't exist on type '%s'", name, s.typeName(obj))
+}
+
+func idField(id, typeName, name string) (any, error) {
+	if name == "id" {
+		return id, nil
+	}
+
+	return nil, apiErrorf("Field '%s' doesn't exist on type '%s'", name, typeName)
+}
+
+func (s *Server) queryField(name string, args map[string]any) (any, error) {
+	s.queries = append(s.queries, name)
+
+	switch name {
+	case "viewer":
+		return s.AddUser(s.Viewer), nil
+	case "repository":
+		owner, repo := stringArg(args, "owner"), stringArg(args, "name")
+
+		r := s.repository(owner, repo)
+		if r == nil {
+			return nil, notFound("Could not resolve to a Repository with the name '%s/%s'.", owner, repo)
+		}
+
+		return r, nil
+	case "user":
+		login := stringArg(args, "login")
+
+		a := s.account(login)
+		if a == nil || a.Organization {
+			return nil, notFound("Could not resolve to a User with the login of '%s'.", login)
+		}
+
+		return a, nil
+	case "organization":
+		login := stringArg(args, "login")
+
+		a := s.account(login)
+		if a == nil || !a.Organization {
+			return nil, notFound("Could not resolve to an Organization with the login of '%s'.", login)
+		}
+
+		return a, nil
+	case "search":
+		return s.search(args)
+	case "repositoryOwner":
+		// GitHub returns null instead of an error for unknown owners.
+		if a := s.account(stringArg(args, "login")); a != nil {
+			return a, nil
+		}
+
+		return nil, nil
+	case "node":
+		id := stringArg(args, "id")
+
+		node := s.node(id)
+		if node == nil {
+			return nil, notFound("Could not resolve to a node with the global id of '%s'", id)
+		}
+
+		return node, nil
+	}
+
+	return nil, apiErrorf("Field '%s' doesn't exist on type 'Query'", name)
+}
+
+// node returns the object with the ID or nil.
+func (s *Server) node(id string) any {
+	for _, a := range s.accounts {
+		if a.ID == id {
+			return a
+		}
+
+		for _, t := range a.Teams {
+			if t.ID == id {
+				return t
+			}
+		}
+	}
+
+	for _, r := range s.repositories {
+		if r.ID == id {
+			return r
+		}
+
+		for _, l := range r.Labels {
+			if l.ID == id {
+				return l
+			}
+		}
+
+		for _, i := range r.Issues {
+			if i.ID == id {
+				return i
+			}
+		}
+
+		for _, pr := range r.PullRequests {
+			if pr.ID == id {
+				return pr
+			}
+
+			for _, c := range pr.Comments {
+				if c.ID == id {
+					return c
+				}
+			}
+		}
+	}
+
+	for _, p := range s.projects {
+		if p.ID == id {
+			return p
+		}
+
+		if p.StatusFieldID == id {
+			return statusField{project: p}
+		}
+
+		for _, f := range p.Fields {
+			if f.ID == id {
+				return f
+			}
+		}
+
+		for _, i := range p.Items {
+			if i.ID == id {
+				return i
+			}
+
+			if d, ok := i.Content.(*DraftIssue); ok && d.ID == id {
+				return d
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *Server) accountField(a *Account, name string, args map[string]any) (any, error) {
+	switch name {
+	case "id":
+		return a.ID, nil
+	case "login":
+		return a.Login, nil
+	case "projectV2":
+		number := intArg(args, "number", 0)
+
+		for _, p := range s.projects {
+			if p.Owner == a && p.Number == number {
+				return p, nil
+			}
+		}
+
+		return nil, notFound("Could not resolve to a ProjectV2 with the number %d.", number)
+	case "repositories":
+		var nodes []any
+
+		for _, r := range s.repositories {
+			if r.Owner == a {
+				nodes = append(nodes, r)
+			}
+		}
+
+		return paginate(nodes, args)
+	case "team":
+		slug := stringArg(args, "slug")
+
+		for _, t := range a.Teams {
+			if strings.EqualFold(t.Slug, slug) {
+				return t, nil
+			}
+		}
+
+		// GitHub returns null for unknown teams
+		return nil, nil
+	}
+
+	return nil, apiErrorf("Field '%s' doesn't exist on type '%s'", name, s.typeName(a))
+}
+
+func (s *Server) repositoryField(r *Repository, name string, args map[string]any) (any, error) {
+	switch name {
+	case "id":
+		return r.ID, nil
+	case "name":
+		return r.Name, nil
+	case "owner":
+		return r.Owner, nil
+	case "nameWithOwner":
+		return r.Owner.Login + "/" + r.Name, nil
+	case "isArchived":
+		return r.IsArchived, nil
+	case "pullRequests":
+		states := map[string]bool{}
+		if list, ok := args["states"].([]any); ok {
+			for _, state := range list {
+				states[fmt.Sprint(state)] = true
+			}
+		} else if state, ok := args["states"].(string); ok {
+			states[state] = true
+		}
+
+		var nodes []any
+
+		for _, pr := range r.PullRequests {
+			state := "OPEN"
+			if pr.Closed {
+				state = "CLOSED"
+			}
+
+			if len(states) == 0 || states[state] {
+				nodes = append(nodes, pr)
+			}
+		}
+
+		return paginate(nodes, args)
+	case "issue":
+		number := intArg(args, "number", 0)
+
+		for _, i := range r.Issues {
+			if i.Number == number {
+				return i, nil
+			}
+		}
+
+		return nil, notFound("Could not resolve to an Issue with the number of %d.", number)
+	case "pullRequest":
+		number := intArg(args, "number", 0)
+
+		for _, pr := range r.PullRequests {
+			if pr.Number == number {
+				return pr, nil
+			}
+		}
+
+		return nil, notFound("Could not resolve to a PullRequest with the number of %d.", number)
+	case "labels":
+		// the search is fuzzy like GitHub's
+		query := strings.ToLower(stringArg(args, "query"))
+
+		var nodes []any
+
+		for _, l := range r.Labels {
+			if strings.Contains(strings.ToLower(l.Name), query) {
+				nodes = append(nodes, l)
+			}
+		}
+
+		return paginate(nodes, args)
+	case "collaborators":
+		query := strings.ToLower(stringArg(args, "query"))
+
+		logins := make([]string, 0, len(r.Collaborators))
+		for login := range r.Collaborators {
+			if strings.Contains(strings.ToLower(login), query) {
+				logins = append(logins, login)
+			}
+		}
+
+		sort.Strings(logins)
+
+		edges := make([]any, 0, len(logins))
+		for _, login := range logins {
+			edges = append(edges, object{"permission": r.Collaborators[login], "node": s.AddUser(login)})
+		}
+
+		return object{"edges": edges}, nil
+	}
+
+	return nil, apiErrorf("Field '%s' doesn't exist on type 'Repository'", name)
+}
+
+// contentField resolves the fields issues and pull requests share. The content is the issue or
+// the pull request, which identifies it in projects.
+func (s *Server) contentField(content any, i *Issue, name string, args map[string]any) (any, error) {
+	switch name {
+	case "id":
+		return i.ID, nil
+	case "number":
+		return i.Number, nil
+	case "title":
+		return i.Title, nil
+	case "closed":
+		return i.Closed, nil
+	case "state":
+		if i.Closed {
+			return "CLOSED", nil
+		}
+
+		return "OPEN", nil
+	case "updatedAt":
+		return i.UpdatedAt, nil
+	case "author":
+		return i.Author, nil
+	case "repository":
+		return i.repository, nil
+	case "labels":
+		nodes := make([]any, 0, len(i.Labels))
+		for _, l := range i.Labels {
+			nodes = append(nodes, l)
+		}
+
+		return paginate(nodes, args)
+	case "assignees":
+		nodes := make([]any, 0, len(i.Assignees))
+		for _, a := range i.Assignees {
+			nodes = append(nodes, a)
+		}
+
+		return paginate(nodes, args)
+	case "projectsV2":
+		return paginate(s.projectsOf(content), args)
+	case "projectItems", "projectV2Items":
+		return paginate(s.itemsOf(content), args)
+	}
+
+	return nil, apiErrorf("Field '%s' doesn't exist on type '%s'", name, s.typeName(content))
+}
+
+func (s *Server) projectsOf(content any) []any {
+	var result []any
+
+	for _, p := range s.projects {
+		if p.ItemOf(content) != nil {
+			result = append(result, p)
+		}
+	}
+
+	return result
+}
+
+func (s *Server) itemsOf(content any) []any {
+	var result []any
+
+	for _, p := range s.projects {
+		if i := p.ItemOf(content); i != nil {
+			result = append(result, i)
+		}
+	}
+
+	return result
+}
+
+func (s *Server) pullRequestField(pr *PullRequest, name string, args map[string]any) (any, error) {
+	switch name {
+	case "body":
+		return pr.Body, nil
+	case "isDraft":
+		return pr.IsDraft, nil
+	case "baseRefName":
+		return pr.BaseRefName, nil
+	case "headRefName":
+		return pr.HeadRefName, nil
+	case "reviewDecision":
+		if pr.ReviewDecision == "" {
+			return nil, nil
+		}
+
+		return pr.ReviewDecision, nil
+	case "reviewRequests":
+		nodes := make([]any, 0, len(pr.ReviewRequests))
+		for _, id := range pr.ReviewRequests {
+			nodes = append(nodes, object{"requestedReviewer": s.node(id)})
+		}
+
+		return paginate(nodes, args)
+	case "latestReviews":
+		nodes := make([]any, 0, len(pr.Reviews))
+		for _, r := range pr.Reviews {
+			nodes = append(nodes, object{"state": r.State, "submittedAt": r.SubmittedAt})
+		}
+
+		return paginate(nodes, args)
+	case "closingIssuesReferences":
+		nodes := make([]any, 0, len(pr.ClosingIssues))
+		for _, i := range pr.ClosingIssues {
+			nodes = append(nodes, i)
+		}
+
+		return paginate(nodes, args)
+	case "comments":
+		nodes := make([]any, 0, len(pr.Comments))
+		for _, c := range pr.Comments {
+			nodes = append(nodes, c)
+		}
+
+		return paginate(nodes, args)
+	}
+
+	return s.contentField(pr, &pr.Issue, name, args)
+}
+
+func (s *Server) commentField(c *Comment, name string) (any, error) {
+	switch name {
+	case "id":
+		return c.ID, nil
+	case "body", "bodyText":
+		return c.Body, nil
+	case "author":
+		return c.Author, nil
+	case "viewerDidAuthor":
+		return c.Author != nil && strings.EqualFold(c.Author.Login, s.Viewer), nil
+	case "reactionGroups":
+		var groups []any
+
+		for _, content := range reactionContents {
+			viewerHasReacted := false
+
+			for _, login := range c.Reactions[content] {
+				viewerHasReacted = viewerHasReacted || strings.EqualFold(login, s.Viewer)
+			}
+
+			groups = append(groups, object{"content": content, "viewerHasReacted": viewerHasReacted})
+		}
+
+		return groups, nil
+	}
+
+	return nil, apiErrorf("Field '%s' doesn't exist on type 'IssueComment'", name)
+}
+
+var reactionContents = []string{"THUMBS_UP", "THUMBS_DOWN", "LAUGH", "HOORAY", "CONFUSED", "HEART", "ROCKET", "EYES"}
+
+func (s *Server) draftIssueField(d *DraftIssue, name string) (any, error) {
+	switch name {
+	case "id":
+		return d.ID, nil
+	case "title":
+		return d.Title, nil
+	case "updatedAt":
+		return d.UpdatedAt, nil
+	case "projectsV2":
+		return paginate(s.projectsOf(d), nil)
+	case "projectV2Items":
+		return paginate(s.itemsOf(d), nil)
+	}
+
+	return nil, apiErrorf("Field '%s' doesn't exist on type 'DraftIssue'", name)
+}
+
+func (s *Server) projectField(p *Project, name string, args map[string]any) (any, error) {
+	switch name {
+	case "id":
+		return p.ID, nil
+	case "number":
+		return p.Number, nil
+	case "title":
+		return p.Title, nil
+	case "owner":
+		return p.Owner, nil
+	case "field":
+		fieldName := stringArg(args, "name")
+		if fieldName == "Status" {
+			return statusField{project: p}, nil
+		}
+
+		if f := p.field(fieldName); f != nil {
+			return f, nil
+		}
+
+		return nil, nil
+	case "items":
+		query, err := filter.Parse(stringArg(args, "query"))
+		if err != nil {
+			return nil, err
+		}
+
+		// like the API, filters hide archived items unless they refer to them
+		hideArchived := query != nil && !query.UsesArchived()
+
+		var nodes []any
+
+		for _, i := range p.Items {
+			if hideArchived && i.IsArchived {
+				continue
+			}
+
+			if query.Match(s.clientItem(i), s.Viewer) {
+				nodes = append(nodes, i)
+			}
+		}
+
+		return paginate(nodes, args)
+	}
+
+	return nil, apiErrorf("Field '%s' doesn't exist on type 'ProjectV2'", name)
+}
+
+func (s *Server) itemField(i *Item, name string, args map[string]any) (any, error) {
+	switch name {
+	case "id":
+		return i.ID, nil
+	case "project":
+		return i.project, nil
+	case "type":
+		return itemType(i.Content), nil
+	case "updatedAt":
+		return i.UpdatedAt, nil
+	case "isArchived":
+		return i.IsArchived, nil
+	case "content":
+		return i.Content, nil
+	case "fieldValueByName":
+		if stringArg(args, "name") != "Status" || i.Status == "" {
+			return nil, nil
+		}
+
+		return statusValue{item: i}, nil
+	}
+
+	return nil, apiErrorf("Field '%s' doesn't exist on type 'ProjectV2Item'", name)
+}
+
+func itemType(content any) string {
+	switch content.(type) {
+	case *Issue:
+		return "ISSUE"
+	case *PullRequest:
+		return "PULL_REQUEST"
+	case *DraftIssue:
+		return "DRAFT_ISSUE"
+	default:
+		return "REDACTED"
+	}
+}
+
+func statusFieldValue(f statusField, name string) (any, error) {
+	switch name {
+	case "id":
+		return f.project.StatusFieldID, nil
+	case "name":
+		return "Status", nil
+	case "dataType":
+		return "SINGLE_SELECT", nil
+	case "options":
+		options := make([]any, 0, len(f.project.Statuses))
+		for _, o := range f.project.Statuses {
+			options = append(options, object{"id": o.ID, "name": o.Name})
+		}
+
+		return options, nil
+	}
+
+	return nil, apiErrorf("Field '%s' doesn't exist on type 'ProjectV2SingleSelectField'", name)
+}
+
+func (s *Server) fieldField(f *Field, name string) (any, error) {
+	switch name {
+	case "id":
+		return f.ID, nil
+	case "name":
+		return f.Name, nil
+	case "dataType":
+		return f.DataType, nil
+	case "options":
+		if f.DataType == "SINGLE_SELECT" {
+			options := make([]any, 0, len(f.Options))
+			for _, o := range f.Options {
+				options = append(options, object{"id": o.ID, "name": o.Name})
+			}
+
+			return options, nil
+		}
+	}
+
+	return nil, apiErrorf("Field '%s' doesn't exist on type '%s'", name, s.typeName(f))
+}
+
+func statusValueField(v statusValue, name string) (any, error) {
+	switch name {
+	case "name":
+		return v.item.Status, nil
+	case "updatedAt":
+		return v.item.StatusUpdatedAt, nil
+	case "optionId":
+		for _, o := range v.item.project.Statuses {
+			if o.Name == v.item.Status {
+				return o.ID, nil
+			}
+		}
+
+		return nil, nil
+	}
+
+	return nil, apiErrorf("Field '%s' doesn't exist on type 'ProjectV2ItemFieldSingleSelectValue'", name)
+}
+
+// paginate returns the page of the nodes selected by the first, last and after arguments. The
+// cursors are the positions of the nodes.
+func paginate(nodes []any, args map[string]any) (connection, error) {
+	start := 0
+
+	if after := stringArg(args, "after"); after != "" {
+		n, err := strconv.Atoi(after)
+		if err != nil {
+			return connection{}, fmt.Errorf("invalid cursor %q", after)
+		}
+
+		start = min(n, len(nodes))
+	}
+
+	end := len(nodes)
+
+	if first := intArg(args, "first", -1); first >= 0 {
+		end = min(start+first, len(nodes))
+	}
+
+	if last := intArg(args, "last", -1); last >= 0 && end-start > last {
+		start = end - last
+	}
+
+	return connection{
+		nodes:      nodes[start:end],
+		totalCount: len(nodes),
+		endCursor:  strconv.Itoa(end),
+		hasNext:    end < len(nodes),
+	}, nil
+}
+
+func connectionField(c connection, name string) (any, error) {
+	switch name {
+	case "nodes":
+		return c.nodes, nil
+	case "totalCount":
+		return c.totalCount, nil
+	case "pageInfo":
+		return object{"endCursor": c.endCursor, "hasNextPage": c.hasNext}, nil
+	}
+
+	return nil, apiErrorf("Field '%s' doesn't exist on connections", name)
+}
+
+func stringArg(args map[string]any, name string) string {
+	switch v := args[name].(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return ""
+	}
+}
+
+func intArg(args map[string]any, name string, fallback int) int {
+	switch v := args[name].(type) {
+	case float64:
+		return int(v)
+	case string:
+		if n, err := strconv.Atoi(v); err == nil {
+			return n
+		}
+	}
+
+	return fallback
+}
@@ -0,0 +1,54 @@
+package githubtest
+
+import (
+	"slices"
+	"strings"
+)
+
+// search finds repositories. The query supports the user, org, topic and archived qualifiers; other
+// terms have to be contained in the name of the repository.
+func (s *Server) search(args map[string]any) (any, error) {
+	if searchType := stringArg(args, "type"); searchType != "REPOSITORY" {
+		return nil, apiErrorf("Search type %s isn't supported", searchType)
+	}
+
+	terms := strings.Fields(stringArg(args, "query"))
+
+	var nodes []any
+
+	for _, r := range s.repositories {
+		if matchesAll(r, terms) {
+			nodes = append(nodes, r)
+		}
+	}
+
+	return paginate(nodes, args)
+}
+
+func matchesAll(r *Repository, terms []string) bool {
+	for _, term := range terms {
+		if !matches(r, term) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func matches(r *Repository, term string) bool {
+	qualifier, value, ok := strings.Cut(term, ":")
+	if !ok {
+		return strings.Contains(strings.ToLower(r.Name), strings.ToLower(term))
+	}
+
+	switch qualifier {
+	case "user", "org":
+		return strings.EqualFold(r.Owner.Login, value)
+	case "topic":
+		return slices.Contains(r.Topics, strings.ToLower(value))
+	case "archived":
+		return r.IsArchived == (value == "true")
+	default:
+		return false
+	}
+}
@@ -0,0 +1,390 @@
+// Package githubtest provides an in-process fake of GitHub's GraphQL API for end-to-end tests.
+// The server keeps an in-memory model of accounts, repositories, issues, pull requests, labels
+// and projects, and implements the queries and mutations Caretaker uses. Tests seed the model,
+// point the client at the server's URL and check the model afterwards.
+package githubtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultViewer is the login of the authenticated user.
+const DefaultViewer = "caretaker-bot"
+
+// Server is a fake GitHub GraphQL API. The model must only be changed while no requests are served.
+type Server struct {
+	*httptest.Server
+
+	// Token is the token requests have to be authenticated with. If empty, any token is accepted.
+	Token string
+	// Viewer is the login of the authenticated user.
+	Viewer string
+	// Now returns the time used for updated timestamps.
+	Now func() time.Time
+
+	mu           sync.Mutex
+	accounts     []*Account
+	repositories []*Repository
+	projects     []*Project
+	lastID       int
+	queries      []string
+	mutations    []string
+	// mutationRequests counts the requests, a batched request executes several mutations.
+	mutationRequests int
+}
+
+// NewServer starts a server with an empty model. It has to be closed by the caller.
+func NewServer() *Server {
+	s := &Server{
+		Viewer: DefaultViewer,
+		Now:    time.Now,
+	}
+
+	s.Server = httptest.NewServer(s)
+
+	return s
+}
+
+// Queries returns the names of the root fields which have been queried in order, for example, node.
+func (s *Server) Queries() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]string(nil), s.queries...)
+}
+
+// MutationRequests returns the number of requests which executed mutations.
+func (s *Server) MutationRequests() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.mutationRequests
+}
+
+// Mutations returns the names of the mutations which have been executed in order.
+func (s *Server) Mutations() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]string(nil), s.mutations...)
+}
+
+func (s *Server) nextID(prefix string) string {
+	s.lastID++
+
+	return fmt.Sprintf("%s_%d", prefix, s.lastID)
+}
+
+// AddUser adds a user account. If it already exists, the existing account is returned.
+func (s *Server) AddUser(login string) *Account {
+	return s.addAccount(login, false)
+}
+
+// AddOrganization adds an organization account. If it already exists, the existing account is returned.
+func (s *Server) AddOrganization(login string) *Account {
+	return s.addAccount(login, true)
+}
+
+func (s *Server) addAccount(login string, organization bool) *Account {
+	if a := s.account(login); a != nil {
+		return a
+	}
+
+	prefix := "U"
+	if organization {
+		prefix = "O"
+	}
+
+	a := &Account{ID: s.nextID(prefix), Login: login, Organization: organization}
+	s.accounts = append(s.accounts, a)
+
+	return a
+}
+
+func (s *Server) account(login string) *Account {
+	for _, a := range s.accounts {
+		if strings.EqualFold(a.Login, login) {
+			return a
+		}
+	}
+
+	return nil
+}
+
+// AddTeam adds a team to an organization.
+func (s *Server) AddTeam(organization, slug string) *Team {
+	org := s.AddOrganization(organization)
+	team := &Team{ID: s.nextID("T"), Slug: slug}
+	org.Teams = append(org.Teams, team)
+
+	return team
+}
+
+// AddRepository adds a repository. A missing owner is added as a user.
+func (s *Server) AddRepository(owner, name string) *Repository {
+	owningAccount := s.account(owner)
+	if owningAccount == nil {
+		owningAccount = s.AddUser(owner)
+	}
+
+	r := &Repository{
+		ID:            s.nextID("R"),
+		Owner:         owningAccount,
+		Name:          name,
+		Collaborators: map[string]string{},
+		server:        s,
+	}
+	s.repositories = append(s.repositories, r)
+
+	return r
+}
+
+func (s *Server) repository(owner, name string) *Repository {
+	for _, r := range s.repositories {
+		if strings.EqualFold(r.Owner.Login, owner) && strings.EqualFold(r.Name, name) {
+			return r
+		}
+	}
+
+	return nil
+}
+
+// AddLabel adds a label to the repository.
+func (r *Repository) AddLabel(name string) *Label {
+	l := &Label{ID: r.server.nextID("LA"), Name: name, Color: "ededed"}
+	r.Labels = append(r.Labels, l)
+
+	return l
+}
+
+// Label returns the label with the name, ignoring case, or nil.
+func (r *Repository) Label(name string) *Label {
+	return findLabel(r.Labels, name)
+}
+
+func (r *Repository) nextNumber() int {
+	return len(r.Issues) + len(r.PullRequests) + 1
+}
+
+// AddIssue adds an open issue authored by the viewer.
+func (r *Repository) AddIssue(title string) *Issue {
+	i := &Issue{
+		ID:         r.server.nextID("I"),
+		Number:     r.nextNumber(),
+		Title:      title,
+		UpdatedAt:  r.server.Now(),
+		Author:     r.server.AddUser(r.server.Viewer),
+		repository: r,
+	}
+	r.Issues = append(r.Issues, i)
+
+	return i
+}
+
+// AddPullRequest adds an open pull request, authored by the viewer, which closes the issues.
+func (r *Repository) AddPullRequest(title string, closingIssues ...*Issue) *PullRequest {
+	pr := &PullRequest{
+		Issue: Issue{
+			ID:         r.server.nextID("PR"),
+			Number:     r.nextNumber(),
+			Title:      title,
+			UpdatedAt:  r.server.Now(),
+			Author:     r.server.AddUser(r.server.Viewer),
+			repository: r,
+		},
+		BaseRefName:   "main",
+		HeadRefName:   fmt.Sprintf("branch-%d", r.nextNumber()),
+		ClosingIssues: closingIssues,
+	}
+	r.PullRequests = append(r.PullRequests, pr)
+
+	return pr
+}
+
+// AddComment adds a comment to the pull request.
+func (pr *PullRequest) AddComment(author, body string) *Comment {
+	s := pr.repository.server
+	c := &Comment{
+		ID:        s.nextID("IC"),
+		Body:      body,
+		Author:    s.AddUser(author),
+		Reactions: map[string][]string{},
+	}
+	pr.Comments = append(pr.Comments, c)
+
+	return c
+}
+
+// AddProject adds a project with a Status field with the given options. A missing owner is added as a user.
+func (s *Server) AddProject(owner string, number int, title string, statuses ...string) *Project {
+	owningAccount := s.account(owner)
+	if owningAccount == nil {
+		owningAccount = s.AddUser(owner)
+	}
+
+	p := &Project{
+		ID:            s.nextID("PVT"),
+		Number:        number,
+		Title:         title,
+		Owner:         owningAccount,
+		StatusFieldID: s.nextID("PVTSSF"),
+		server:        s,
+	}
+
+	for _, status := range statuses {
+		p.AddStatus(status)
+	}
+
+	s.projects = append(s.projects, p)
+
+	return p
+}
+
+// AddStatus adds an option to the Status field.
+func (p *Project) AddStatus(name string) *StatusOption {
+	o := &StatusOption{ID: p.server.nextID("OPT"), Name: name}
+	p.Statuses = append(p.Statuses, o)
+
+	return o
+}
+
+// AddField adds a field with the data type, for example, TEXT or SINGLE_SELECT, and the options of a single
+// select field.
+func (p *Project) AddField(name, dataType string, options ...string) *Field {
+	f := &Field{ID: p.server.nextID("PVTF"), Name: name, DataType: dataType}
+
+	for _, option := range options {
+		f.Options = append(f.Options, &StatusOption{ID: p.server.nextID("OPT"), Name: option})
+	}
+
+	p.Fields = append(p.Fields, f)
+
+	return f
+}
+
+// field returns the field besides Status with the name or nil.
+func (p *Project) field(name string) *Field {
+	for _, f := range p.Fields {
+		if f.Name == name {
+			return f
+		}
+	}
+
+	return nil
+}
+
+// AddItem adds an issue or pull request to the project. If it's already in the project, the existing item
+// is returned.
+func (p *Project) AddItem(content any) *Item {
+	if i := p.ItemOf(content); i != nil {
+		return i
+	}
+
+	i := &Item{
+		ID:        p.server.nextID("PVTI"),
+		Content:   content,
+		UpdatedAt: p.server.Now(),
+		project:   p,
+	}
+	p.Items = append(p.Items, i)
+
+	return i
+}
+
+// AddDraftIssue adds a draft issue to the project.
+func (p *Project) AddDraftIssue(title string) *Item {
+	return p.AddItem(&DraftIssue{ID: p.server.nextID("DI"), Title: title, UpdatedAt: p.server.Now()})
+}
+
+// ItemOf returns the item of the issue, pull request or draft issue, or nil.
+func (p *Project) ItemOf(content any) *Item {
+	for _, i := range p.Items {
+		if i.Content == content {
+			return i
+		}
+	}
+
+	return nil
+}
+
+func (p *Project) status(optionID string) *StatusOption {
+	for _, o := range p.Statuses {
+		if o.ID == optionID {
+			return o
+		}
+	}
+
+	return nil
+}
+
+type request struct {
+	Query     string         `json:"query"`
+	Variables map[string]any `json:"variables"`
+}
+
+type response struct {
+	Data   any            `json:"data"`
+	Errors []graphQLError `json:"errors,omitempty"`
+}
+
+type graphQLError struct {
+	Message string `json:"message"`
+	Type    string `json:"type,omitempty"`
+	Path    []any  `json:"path,omitempty"`
+}
+
+// ServeHTTP executes a GraphQL request against the model.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "only POST is supported", http.StatusMethodNotAllowed)
+
+		return
+	}
+
+	if s.Token != "" && r.Header.Get("Authorization") != "Bearer "+s.Token {
+		w.WriteHeader(http.StatusUnauthorized)
+		_ = json.NewEncoder(w).Encode(map[string]string{"message": "Bad credentials"})
+
+		return
+	}
+
+	var req request
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, fmt.Sprintf("failed to decode request: %s", err), http.StatusBadRequest)
+
+		return
+	}
+
+	op, err := parse(req.Query)
+	if err != nil {
+		writeResponse(w, response{Errors: []graphQLError{{Message: err.Error(), Type: "PARSE_ERROR"}}})
+
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e := &executor{server: s, variables: req.Variables}
+
+	var root any = queryRoot{}
+	if op.mutation {
+		root = mutationRoot{}
+		s.mutationRequests++
+	}
+
+	data := e.selectionSet(root, op.selections, nil)
+
+	writeResponse(w, response{Data: data, Errors: e.errors})
+}
+
+func writeResponse(w http.ResponseWriter, resp response) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
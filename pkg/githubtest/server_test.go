@@ -0,0 +1,238 @@
+package githubtest
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+
+	"github.com/skarlso/caretaker/pkg/client"
+	"github.com/skarlso/caretaker/pkg/logger"
+)
+
+func newCaretaker(t *testing.T, s *Server, opts client.Options) *client.Caretaker {
+	t.Helper()
+
+	httpClient := oauth2.NewClient(context.Background(), oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "token"}))
+
+	return client.NewCaretaker(&logger.QuiteLogger{}, githubv4.NewEnterpriseClient(s.URL, httpClient), opts)
+}
+
+func TestParse(t *testing.T) {
+	op, err := parse(`mutation($input:AddCommentInput!$input1:AddCommentInput!){` +
+		`m0: addComment(input: $input){subject{id}},` +
+		`m1: addComment(input: $input1){subject{id,... on PullRequest{number}}}}`)
+	require.NoError(t, err)
+
+	assert.True(t, op.mutation)
+	require.Len(t, op.selections, 2)
+	assert.Equal(t, "m1", op.selections[1].key())
+	assert.Equal(t, "addComment", op.selections[1].name)
+	assert.Equal(t, "input1", op.selections[1].arguments["input"].variable)
+
+	subject := op.selections[1].selections[0]
+	require.Len(t, subject.selections, 2)
+	assert.Equal(t, "PullRequest", subject.selections[1].typeCondition)
+
+	op, err = parse(`{repository(owner: "skarlso", name: $name){pullRequests(first: 100, states: OPEN){totalCount}}}`)
+	require.NoError(t, err)
+
+	args := op.selections[0].selections[0].arguments
+	assert.Equal(t, float64(100), args["first"].literal)
+	assert.Equal(t, "OPEN", args["states"].literal)
+	assert.Equal(t, "skarlso", op.selections[0].arguments["owner"].literal)
+
+	_, err = parse(`query{repository(owner: "skarlso"){id}`)
+	assert.Error(t, err)
+}
+
+func TestServer_PullRequestsAndLabels(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	repo := s.AddRepository("skarlso", "caretaker")
+	repo.AddLabel("bug-report")
+	issue := repo.AddIssue("issue")
+	pr := repo.AddPullRequest("pull request", issue)
+	closed := repo.AddPullRequest("closed")
+	closed.Closed = true
+
+	project := s.AddProject("skarlso", 1, "board", "Todo", "Done")
+	project.AddItem(issue).SetStatus("Todo")
+
+	c := newCaretaker(t, s, client.Options{Owner: "skarlso", Repo: "caretaker"})
+	ctx := context.Background()
+
+	prs, err := c.PullRequests(ctx)
+	require.NoError(t, err)
+	require.Len(t, prs, 1)
+	assert.Equal(t, githubv4.Int(pr.Number), prs[0].Number)
+	require.Len(t, prs[0].ClosingIssuesReferences.Nodes, 1)
+
+	linked := prs[0].ClosingIssuesReferences.Nodes[0]
+	assert.Equal(t, githubv4.Int(issue.Number), linked.Number)
+	require.Len(t, linked.ProjectItems.Nodes, 1)
+	assert.Equal(t, githubv4.String("Todo"), linked.ProjectItems.Nodes[0].FieldValueByName.ProjectV2SingleSelectField.Name)
+
+	err = c.AddLabel(ctx, "bug", prs[0].ID)
+	require.ErrorIs(t, err, client.ErrLabelNotFound)
+
+	c.CreateMissingLabels = true
+	require.NoError(t, c.AddLabel(ctx, "bug", prs[0].ID))
+	assert.True(t, pr.HasLabel("bug"))
+	assert.NotNil(t, repo.Label("bug"))
+
+	require.NoError(t, c.RemoveLabel(ctx, "bug", prs[0].ID))
+	assert.False(t, pr.HasLabel("bug"))
+
+	result, err := c.UpdateIssueStatus(ctx, linked, "Done", 0)
+	require.NoError(t, err)
+	assert.True(t, result.Updated())
+	assert.Equal(t, "Done", project.ItemOf(issue).Status)
+
+	assert.Equal(t, []string{"createLabel", "addLabelsToLabelable", "removeLabelsFromLabelable",
+		"updateProjectV2ItemFieldValue"}, s.Mutations())
+}
+
+func TestServer_ProjectItems(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	s.AddOrganization("open-source")
+	repo := s.AddRepository("open-source", "caretaker")
+	project := s.AddProject("open-source", 2, "board", "Todo", "Done")
+
+	// more than a page of items
+	for i := range 120 {
+		issue := repo.AddIssue(fmt.Sprintf("issue %d", i))
+		if i%2 == 0 {
+			issue.Labels = append(issue.Labels, repo.AddLabel(fmt.Sprintf("label-%d", i)))
+		}
+
+		project.AddItem(issue).SetStatus("Todo")
+	}
+
+	draft := project.AddDraftIssue("draft")
+
+	c := newCaretaker(t, s, client.Options{Owner: "open-source", Repo: "caretaker"})
+	ctx := context.Background()
+
+	items, err := c.ProjectItems(ctx, 2, "")
+	require.NoError(t, err)
+	assert.Len(t, items, 121)
+
+	items, err = c.ProjectItems(ctx, 2, "is:draft")
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, githubv4.String(client.DraftIssueType), items[0].Type)
+
+	items, err = c.ProjectItems(ctx, 2, "has:label -label:label-0")
+	require.NoError(t, err)
+	assert.Len(t, items, 59)
+
+	_, err = c.ProjectItems(ctx, 3, "")
+	require.ErrorIs(t, err, client.ErrProjectNotFound)
+
+	issue, err := c.ConvertDraftIssue(ctx, githubv4.ID(draft.ID), "")
+	require.NoError(t, err)
+	assert.Equal(t, githubv4.String("draft"), issue.Title)
+	assert.Len(t, repo.Issues, 121)
+
+	require.NoError(t, c.ArchiveProjectItem(ctx, project.ID, draft.ID))
+	assert.True(t, draft.IsArchived)
+
+	// filters hide archived items unless they ask for them
+	items, err = c.ProjectItems(ctx, 2, "is:issue")
+	require.NoError(t, err)
+	assert.Len(t, items, 120)
+
+	items, err = c.ProjectItems(ctx, 2, "is:issue is:archived")
+	require.NoError(t, err)
+	assert.Len(t, items, 1)
+
+	items, err = c.ProjectItems(ctx, 2, "")
+	require.NoError(t, err)
+	assert.Len(t, items, 121)
+
+	require.NoError(t, c.DeleteProjectItem(ctx, project.ID, draft.ID))
+	assert.Nil(t, project.ItemOf(draft.Content))
+}
+
+func TestServer_SlashCommandQueries(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	repo := s.AddRepository("skarlso", "caretaker")
+	repo.Collaborators["maintainer"] = "MAINTAIN"
+	repo.Collaborators["maintainer-bot"] = "READ"
+	pr := repo.AddPullRequest("pull request")
+	comment := pr.AddComment("maintainer", "/label bug")
+	team := s.AddTeam("open-source", "reviewers")
+
+	c := newCaretaker(t, s, client.Options{Owner: "skarlso", Repo: "caretaker"})
+	ctx := context.Background()
+
+	permission, err := c.RepositoryPermission(ctx, "maintainer")
+	require.NoError(t, err)
+	assert.Equal(t, githubv4.RepositoryPermissionMaintain, permission)
+
+	reacted, err := c.ViewerHasReacted(ctx, comment.ID, githubv4.ReactionContentThumbsUp)
+	require.NoError(t, err)
+	assert.False(t, reacted)
+
+	require.NoError(t, c.AddReaction(ctx, comment.ID, githubv4.ReactionContentThumbsUp))
+
+	reacted, err = c.ViewerHasReacted(ctx, comment.ID, githubv4.ReactionContentThumbsUp)
+	require.NoError(t, err)
+	assert.True(t, reacted)
+
+	user, err := c.User(ctx, "maintainer")
+	require.NoError(t, err)
+	require.NoError(t, c.AssignUserToAssignable(ctx, user.ID, pr.ID))
+	require.Len(t, pr.Assignees, 1)
+	assert.Equal(t, "maintainer", pr.Assignees[0].Login)
+
+	found, err := c.Team(ctx, "open-source", "reviewers")
+	require.NoError(t, err)
+	require.NoError(t, c.RequestReviews(ctx, pr.ID, []githubv4.ID{user.ID}, []githubv4.ID{found.ID}))
+	assert.Equal(t, []string{user.ID.(string), team.ID}, pr.ReviewRequests)
+
+	_, err = c.Team(ctx, "open-source", "missing")
+	assert.Error(t, err)
+
+	require.NoError(t, c.LeaveComment(ctx, pr.ID, "done"))
+
+	comments, err := c.Comments(ctx, pr.Number)
+	require.NoError(t, err)
+	require.Len(t, comments, 2)
+	assert.True(t, bool(comments[1].ViewerDidAuthor))
+
+	require.NoError(t, c.UpdateComment(ctx, comments[1].ID, "updated"))
+	assert.Equal(t, "updated", pr.Comments[1].Body)
+
+	require.NoError(t, c.UpdatePullRequestBody(ctx, pr.ID, "body"))
+	assert.Equal(t, "body", pr.Body)
+}
+
+func TestServer_Errors(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	s.Token = "secret"
+	s.AddRepository("skarlso", "caretaker")
+
+	c := newCaretaker(t, s, client.Options{Owner: "skarlso", Repo: "caretaker"})
+
+	_, err := c.PullRequests(context.Background())
+	require.ErrorIs(t, err, client.ErrForbidden)
+
+	s.Token = ""
+
+	_, err = c.Issue(context.Background(), 42)
+	require.ErrorIs(t, err, client.ErrNotFound)
+	assert.ErrorContains(t, err, "Could not resolve to an Issue with the number of 42.")
+}
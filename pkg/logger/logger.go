@@ -11,19 +11,18 @@ type Logger interface {
 	Debug(message string, args ...any)
 }
 
-// VerboseLogger logs debug messages.
+// VerboseLogger logs debug messages. Every message is written at once, so messages of
+// concurrent workers end up on separate lines.
 type VerboseLogger struct{}
 
 // Log just logs normal messages.
 func (*VerboseLogger) Log(message string, args ...any) {
-	fmt.Printf(message, args...)
-	fmt.Println()
+	fmt.Printf(message+"\n", args...)
 }
 
 // Debug is used for messages which can normally be ignored.
 func (*VerboseLogger) Debug(message string, args ...any) {
-	fmt.Printf(message, args...)
-	fmt.Println()
+	fmt.Printf(message+"\n", args...)
 }
 
 // QuiteLogger 's LogDebug is ignored.
@@ -31,8 +30,7 @@ type QuiteLogger struct{}
 
 // Log just logs normal messages.
 func (*QuiteLogger) Log(message string, args ...any) {
-	fmt.Printf(message, args...)
-	fmt.Println()
+	fmt.Printf(message+"\n", args...)
 }
 
 // Debug is ignored.
@@ -2,12 +2,15 @@ package pullrequestupdated
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync/atomic"
 
 	"github.com/shurcooL/githubv4"
 
 	"github.com/skarlso/caretaker/pkg/client"
 	"github.com/skarlso/caretaker/pkg/logger"
+	"github.com/skarlso/caretaker/pkg/worker"
 )
 
 type Options struct {
@@ -15,6 +18,8 @@ type Options struct {
 	StatusName        string
 	ScanLabel         string
 	NoComment         bool
+	// Concurrency is the number of issues updated at the same time.
+	Concurrency int
 }
 
 type Updater struct {
@@ -45,7 +50,10 @@ func (c *Updater) PullRequestUpdated(ctx context.Context) error {
 		return nil
 	}
 
-	var updated bool
+	var (
+		updated atomic.Bool
+		tasks   []worker.Task
+	)
 
 	for _, issue := range pr.ClosingIssuesReferences.Nodes {
 		issue := issue
@@ -57,21 +65,40 @@ func (c *Updater) PullRequestUpdated(ctx context.Context) error {
 			continue
 		}
 
-		// if any of its project items is not in the desired state, we'll update it.
-		updated, err = c.client.UpdateIssueStatus(ctx, issue, githubv4.String(c.StatusName), -1)
-		if err != nil {
-			return fmt.Errorf("failed to mutate issue: %w", err)
-		}
+		tasks = append(tasks, worker.Task{
+			Name: fmt.Sprintf("issue %d", issue.Number),
+			Steps: []worker.Step{func(ctx context.Context) error {
+				// if any of its project items is not in the desired state, we'll update it.
+				result, err := c.client.UpdateIssueStatus(ctx, issue, githubv4.String(c.StatusName), -1)
+				if err != nil {
+					return fmt.Errorf("failed to mutate issue: %w", err)
+				}
+
+				if result.Updated() {
+					updated.Store(true)
+				}
+
+				c.log.Debug("issue number %d successfully mutated", issue.Number)
+
+				return nil
+			}},
+		})
+	}
+
+	// the label is removed even if some of the issues failed, so the next scan picks the pull request up again
+	updateErr := worker.NewPool(c.log, c.Concurrency).Run(ctx, tasks)
 
-		c.log.Debug("issue number %d successfully mutated", issue.Number)
+	// a label which doesn't exist can't be on the pull request, so there is nothing to remove
+	if err := c.client.RemoveLabel(ctx, c.ScanLabel, pr.ID); err != nil && !errors.Is(err, client.ErrLabelNotFound) {
+		return errors.Join(updateErr, fmt.Errorf("failed to remove label from entity: %w", err))
 	}
 
-	if err := c.client.RemoveLabel(ctx, c.ScanLabel, pr.ID); err != nil {
-		return fmt.Errorf("failed to remove label from entity: %w", err)
+	if updateErr != nil {
+		return updateErr
 	}
 
 	// if there was no update performed, don't leave a comment
-	if !c.NoComment && updated {
+	if !c.NoComment && updated.Load() {
 		if err := c.client.LeaveComment(
 			ctx,
 			pr.ID,
@@ -0,0 +1,296 @@
+// Package recording captures the GraphQL requests Caretaker sends and the responses of GitHub, and serves them
+// again. A recording of a run that went wrong can be replayed in a regression test or while debugging.
+package recording
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/shurcooL/githubv4"
+
+	"github.com/skarlso/caretaker/pkg/client"
+	"github.com/skarlso/caretaker/pkg/logger"
+)
+
+// Redacted replaces secrets in recordings.
+const Redacted = "REDACTED"
+
+// replayURL is the endpoint of the replaying client. Requests never leave the process.
+const replayURL = "http://replay.invalid/graphql"
+
+// Exchange is a recorded request and its response.
+type Exchange struct {
+	Request    json.RawMessage `json:"request"`
+	StatusCode int             `json:"statusCode"`
+	Response   json.RawMessage `json:"response"`
+}
+
+// Recorder is a transport which writes every exchange into a file in the directory. The headers of the requests
+// aren't recorded, so the token isn't either. The secrets are replaced in the recorded bodies as well.
+type Recorder struct {
+	log       logger.Logger
+	dir       string
+	transport http.RoundTripper
+	secrets   []string
+
+	mu    sync.Mutex
+	count int
+}
+
+// NewRecorder creates the directory and returns a transport recording the exchanges of the transport into it.
+func NewRecorder(log logger.Logger, dir string, transport http.RoundTripper, secrets ...string) (*Recorder, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create recording directory: %w", err)
+	}
+
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	var nonEmpty []string
+
+	for _, s := range secrets {
+		if s != "" {
+			nonEmpty = append(nonEmpty, s)
+		}
+	}
+
+	return &Recorder{log: log, dir: dir, transport: transport, secrets: nonEmpty}, nil
+}
+
+// RoundTrip sends the request and records it together with the response. A failure to write the recording is
+// only logged, since GitHub has already handled the request and failing it would make callers send it again.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var requestBody []byte
+
+	if req.Body != nil {
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read request body: %w", err)
+		}
+
+		requestBody = body
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	responseBody, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	resp.Body = io.NopCloser(bytes.NewReader(responseBody))
+
+	if err := r.write(requestBody, resp.StatusCode, responseBody); err != nil {
+		r.log.Log("failed to record exchange: %s", err)
+	}
+
+	return resp, nil
+}
+
+func (r *Recorder) write(request []byte, statusCode int, response []byte) error {
+	exchange := Exchange{
+		Request:    r.redact(request),
+		StatusCode: statusCode,
+		Response:   r.redact(response),
+	}
+
+	content, err := json.MarshalIndent(exchange, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode exchange: %w", err)
+	}
+
+	r.mu.Lock()
+	r.count++
+	name := fmt.Sprintf("%04d-%s.json", r.count, operationName(request))
+	r.mu.Unlock()
+
+	if err := os.WriteFile(filepath.Join(r.dir, name), content, 0o600); err != nil {
+		return fmt.Errorf("failed to write recording: %w", err)
+	}
+
+	return nil
+}
+
+// redact replaces the secrets and makes sure the body is valid JSON, so it can be embedded.
+func (r *Recorder) redact(body []byte) json.RawMessage {
+	for _, s := range r.secrets {
+		body = bytes.ReplaceAll(body, []byte(s), []byte(Redacted))
+	}
+
+	if !json.Valid(body) {
+		quoted, _ := json.Marshal(string(body))
+
+		return quoted
+	}
+
+	return body
+}
+
+var firstFieldRegex = regexp.MustCompile(`^\s*(?:query|mutation)?[^{]*\{\s*(?:\w+\s*:\s*)?(\w+)`)
+
+// operationName returns the first field of the GraphQL document in the request for the file name.
+func operationName(request []byte) string {
+	var body struct {
+		Query string `json:"query"`
+	}
+
+	if err := json.Unmarshal(request, &body); err != nil {
+		return "request"
+	}
+
+	name := "query"
+	if strings.HasPrefix(strings.TrimSpace(body.Query), "mutation") {
+		name = "mutation"
+	}
+
+	if match := firstFieldRegex.FindStringSubmatch(body.Query); match != nil {
+		name += "-" + match[1]
+	}
+
+	return name
+}
+
+// Replayer is a transport which answers requests with the recorded responses. A request is answered by the
+// first unused exchange with an identical request, so replays don't depend on the order of concurrent requests.
+// Requests which haven't been recorded fail.
+type Replayer struct {
+	mu        sync.Mutex
+	exchanges map[string][]recorded
+}
+
+type recorded struct {
+	file     string
+	exchange Exchange
+}
+
+// NewReplayer loads the exchanges recorded into the directory.
+func NewReplayer(dir string) (*Replayer, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list recordings: %w", err)
+	}
+
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no recordings found in %s", dir)
+	}
+
+	sort.Strings(files)
+
+	r := &Replayer{exchanges: map[string][]recorded{}}
+
+	for _, file := range files {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read recording: %w", err)
+		}
+
+		var exchange Exchange
+		if err := json.Unmarshal(content, &exchange); err != nil {
+			return nil, fmt.Errorf("failed to decode recording %s: %w", file, err)
+		}
+
+		key, err := requestKey(exchange.Request)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode request of recording %s: %w", file, err)
+		}
+
+		r.exchanges[key] = append(r.exchanges[key], recorded{file: filepath.Base(file), exchange: exchange})
+	}
+
+	return r, nil
+}
+
+// requestKey normalizes the request, so requests match regardless of the formatting and the order of the variables.
+func requestKey(request []byte) (string, error) {
+	var body any
+	if err := json.Unmarshal(request, &body); err != nil {
+		return "", err
+	}
+
+	key, err := json.Marshal(body)
+
+	return string(key), err
+}
+
+// RoundTrip answers the request with the next recorded response for it.
+func (r *Replayer) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+
+	if req.Body != nil {
+		b, err := io.ReadAll(req.Body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read request body: %w", err)
+		}
+
+		body = b
+	}
+
+	key, err := requestKey(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode request: %w", err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	queue := r.exchanges[key]
+	if len(queue) == 0 {
+		return nil, fmt.Errorf("no recorded response for request %s", bytes.TrimSpace(body))
+	}
+
+	next := queue[0]
+	r.exchanges[key] = queue[1:]
+
+	return &http.Response{
+		StatusCode: next.exchange.StatusCode,
+		Status:     fmt.Sprintf("%d %s", next.exchange.StatusCode, http.StatusText(next.exchange.StatusCode)),
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(bytes.NewReader(next.exchange.Response)),
+		Request:    req,
+	}, nil
+}
+
+// Unused returns the files of the exchanges which haven't been replayed. After a successful replay of a whole
+// run, it's empty.
+func (r *Replayer) Unused() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var result []string
+
+	for _, queue := range r.exchanges {
+		for _, rec := range queue {
+			result = append(result, rec.file)
+		}
+	}
+
+	sort.Strings(result)
+
+	return result
+}
+
+// NewReplayClient returns a GraphQL client answering with the exchanges recorded into the directory.
+func NewReplayClient(dir string) (client.GraphQLClient, *Replayer, error) {
+	replayer, err := NewReplayer(dir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return githubv4.NewEnterpriseClient(replayURL, &http.Client{Transport: replayer}), replayer, nil
+}
@@ -0,0 +1,111 @@
+package recording
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+
+	"github.com/skarlso/caretaker/pkg/client"
+	"github.com/skarlso/caretaker/pkg/githubtest"
+	"github.com/skarlso/caretaker/pkg/logger"
+)
+
+func TestRecordAndReplay(t *testing.T) {
+	s := githubtest.NewServer()
+	defer s.Close()
+
+	s.Token = "ghp_secret"
+	repo := s.AddRepository("skarlso", "caretaker")
+	repo.AddLabel("bug")
+	pr := repo.AddPullRequest("pull request mentioning ghp_secret")
+
+	dir := t.TempDir()
+
+	httpClient := oauth2.NewClient(context.Background(), oauth2.StaticTokenSource(&oauth2.Token{AccessToken: s.Token}))
+	recorder, err := NewRecorder(&logger.QuiteLogger{}, dir, httpClient.Transport, s.Token)
+	require.NoError(t, err)
+
+	httpClient.Transport = recorder
+
+	opts := client.Options{Owner: "skarlso", Repo: "caretaker"}
+	recorded := client.NewCaretaker(&logger.QuiteLogger{}, githubv4.NewEnterpriseClient(s.URL, httpClient), opts)
+
+	ctx := context.Background()
+
+	prs, err := recorded.PullRequests(ctx)
+	require.NoError(t, err)
+	require.NoError(t, recorded.AddLabel(ctx, "bug", prs[0].ID))
+	assert.True(t, pr.HasLabel("bug"))
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	require.NoError(t, err)
+	require.Len(t, files, 3)
+	assert.Equal(t, "0001-query-repository.json", filepath.Base(files[0]))
+	assert.Equal(t, "0003-mutation-addLabelsToLabelable.json", filepath.Base(files[2]))
+
+	for _, file := range files {
+		content, err := os.ReadFile(file)
+		require.NoError(t, err)
+		assert.NotContains(t, string(content), s.Token)
+	}
+
+	gclient, replayer, err := NewReplayClient(dir)
+	require.NoError(t, err)
+
+	replayed := client.NewCaretaker(&logger.QuiteLogger{}, gclient, opts)
+
+	replayedPRs, err := replayed.PullRequests(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, githubv4.String("pull request mentioning "+Redacted), replayedPRs[0].Title)
+	assert.Equal(t, prs[0].ID, replayedPRs[0].ID)
+
+	require.NoError(t, replayed.AddLabel(ctx, "bug", replayedPRs[0].ID))
+	assert.Empty(t, replayer.Unused())
+
+	// a request which hasn't been recorded fails
+	err = replayed.AddLabel(ctx, "bug", replayedPRs[0].ID)
+	assert.ErrorContains(t, err, "no recorded response for request")
+}
+
+func TestRecorder_WriteFailure(t *testing.T) {
+	s := githubtest.NewServer()
+	defer s.Close()
+
+	pr := s.AddRepository("skarlso", "caretaker").AddPullRequest("pull request")
+
+	dir := filepath.Join(t.TempDir(), "recording")
+
+	recorder, err := NewRecorder(&logger.QuiteLogger{}, dir, s.Client().Transport)
+	require.NoError(t, err)
+	require.NoError(t, os.RemoveAll(dir))
+
+	c := client.NewCaretaker(&logger.QuiteLogger{}, githubv4.NewEnterpriseClient(s.URL, &http.Client{Transport: recorder}),
+		client.Options{Owner: "skarlso", Repo: "caretaker", CreateMissingLabels: true})
+
+	// the request has been handled by GitHub, so it succeeds even though it can't be recorded
+	require.NoError(t, c.AddLabel(context.Background(), "bug", githubv4.ID(pr.ID)))
+	assert.True(t, pr.HasLabel("bug"))
+}
+
+func TestReplayer_StatusCode(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "0001-query-viewer.json"), []byte(`{
+		"request": {"query": "{viewer{login}}"},
+		"statusCode": 401,
+		"response": {"message": "Bad credentials"}
+	}`), 0o600))
+
+	gclient, _, err := NewReplayClient(dir)
+	require.NoError(t, err)
+
+	_, err = client.NewCaretaker(&logger.QuiteLogger{}, gclient, client.Options{}).ViewerLogin(context.Background())
+	require.ErrorIs(t, err, client.ErrForbidden)
+	assert.ErrorContains(t, err, http.StatusText(http.StatusUnauthorized))
+}
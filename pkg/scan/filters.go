@@ -0,0 +1,93 @@
+package scan
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/skarlso/caretaker/pkg/client"
+)
+
+// Filters define which pull requests the scanner ignores. Authors and branches are
+// glob patterns as understood by path.Match, for example, `release/*` or `renovate*`.
+type Filters struct {
+	// ExcludeLabels skips pull requests which have any of these labels.
+	ExcludeLabels []string
+	// RequireLabels skips pull requests which don't have all of these labels.
+	RequireLabels []string
+	// ExcludeAuthors skips pull requests opened by a matching author login.
+	ExcludeAuthors []string
+	// SkipDrafts skips draft pull requests.
+	SkipDrafts bool
+	// BaseBranches, if set, only keeps pull requests targeting a matching base branch.
+	BaseBranches []string
+	// ExcludeBaseBranches skips pull requests targeting a matching base branch.
+	ExcludeBaseBranches []string
+	// HeadBranches, if set, only keeps pull requests opened from a matching head branch.
+	HeadBranches []string
+	// ExcludeHeadBranches skips pull requests opened from a matching head branch.
+	ExcludeHeadBranches []string
+}
+
+// skip returns the reason if the pull request should be skipped by the scanner.
+func (f Filters) skip(pr client.PullRequest) (string, bool) {
+	if f.SkipDrafts && bool(pr.IsDraft) {
+		return "it's a draft", true
+	}
+
+	labels := make(map[string]struct{}, len(pr.Labels.Nodes))
+	for _, label := range pr.Labels.Nodes {
+		labels[strings.ToLower(string(label.Name))] = struct{}{}
+	}
+
+	for _, label := range f.ExcludeLabels {
+		if _, ok := labels[strings.ToLower(label)]; ok {
+			return fmt.Sprintf("it has the excluded label %s", label), true
+		}
+	}
+
+	for _, label := range f.RequireLabels {
+		if _, ok := labels[strings.ToLower(label)]; !ok {
+			return fmt.Sprintf("it doesn't have the required label %s", label), true
+		}
+	}
+
+	author := string(pr.Author.Login)
+	if matchAny(f.ExcludeAuthors, author) {
+		return fmt.Sprintf("author %s is excluded", author), true
+	}
+
+	base := string(pr.BaseRefName)
+	if len(f.BaseBranches) > 0 && !matchAny(f.BaseBranches, base) {
+		return fmt.Sprintf("base branch %s is not included", base), true
+	}
+
+	if matchAny(f.ExcludeBaseBranches, base) {
+		return fmt.Sprintf("base branch %s is excluded", base), true
+	}
+
+	head := string(pr.HeadRefName)
+	if len(f.HeadBranches) > 0 && !matchAny(f.HeadBranches, head) {
+		return fmt.Sprintf("head branch %s is not included", head), true
+	}
+
+	if matchAny(f.ExcludeHeadBranches, head) {
+		return fmt.Sprintf("head branch %s is excluded", head), true
+	}
+
+	return "", false
+}
+
+// matchAny returns whether the value matches any of the glob patterns. Matching is case-insensitive.
+func matchAny(patterns []string, value string) bool {
+	value = strings.ToLower(value)
+
+	for _, pattern := range patterns {
+		// the only possible error is a malformed pattern, which then doesn't match anything
+		if ok, _ := path.Match(strings.ToLower(pattern), value); ok {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,160 @@
+package scan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/skarlso/caretaker/pkg/client"
+	"github.com/skarlso/caretaker/pkg/logger"
+)
+
+// ResolveRepositories returns the repositories of the owner to scan. Patterns are names or glob patterns,
+// like api-*, which are matched against the repositories of the owner. The repositories matching the search
+// query, like topic:caretaker, are added. Archived repositories are skipped and every repository is returned
+// once, in the order it's found.
+func ResolveRepositories(
+	ctx context.Context,
+	log logger.Logger,
+	c client.Client,
+	patterns []string,
+	search string,
+) ([]string, error) {
+	var (
+		result []string
+		owned  []string
+		listed bool
+	)
+
+	seen := map[string]bool{}
+	add := func(names ...string) {
+		for _, name := range names {
+			if key := strings.ToLower(name); !seen[key] {
+				seen[key] = true
+				result = append(result, name)
+			}
+		}
+	}
+
+	for _, pattern := range patterns {
+		if !strings.ContainsAny(pattern, "*?[") {
+			add(pattern)
+
+			continue
+		}
+
+		if !listed {
+			var err error
+			if owned, err = c.Repositories(ctx); err != nil {
+				return nil, err
+			}
+
+			listed = true
+		}
+
+		matched := false
+
+		for _, name := range owned {
+			if ok, err := path.Match(strings.ToLower(pattern), strings.ToLower(name)); err != nil {
+				return nil, fmt.Errorf("invalid repository pattern %s: %w", pattern, err)
+			} else if ok {
+				add(name)
+
+				matched = true
+			}
+		}
+
+		if !matched {
+			log.Log("no repository matches the pattern %s", pattern)
+		}
+	}
+
+	if search != "" {
+		found, err := c.SearchRepositories(ctx, search)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(found) == 0 {
+			log.Log("no repository matches the search %s", search)
+		}
+
+		add(found...)
+	}
+
+	if len(result) == 0 {
+		return nil, errors.New("no repositories to scan")
+	}
+
+	return result, nil
+}
+
+// RepositoryResult is the result of the scan of a single repository.
+type RepositoryResult struct {
+	Result
+
+	Repository string
+	Err        error
+}
+
+// MultiScanner scans several repositories of the owner with the same options.
+type MultiScanner struct {
+	Options
+
+	// newClient returns a client for the repository.
+	newClient func(repository string) client.Client
+	log       logger.Logger
+}
+
+func NewMultiScanner(log logger.Logger, newClient func(repository string) client.Client, opts Options) *MultiScanner {
+	return &MultiScanner{
+		log:       log,
+		newClient: newClient,
+		Options:   opts,
+	}
+}
+
+// Scan scans the repositories one after the other. A failing repository doesn't stop the scan of the others;
+// the errors of all repositories are returned together with the results.
+func (m *MultiScanner) Scan(ctx context.Context, repositories []string) ([]RepositoryResult, error) {
+	results := make([]RepositoryResult, 0, len(repositories))
+
+	var errs []error
+
+	for _, repository := range repositories {
+		m.log.Log("scanning repository %s", repository)
+
+		scanner := NewScanner(m.log, m.newClient(repository), m.Options)
+		result, err := scanner.Scan(ctx)
+
+		if err != nil {
+			errs = append(errs, fmt.Errorf("repository %s: %w", repository, err))
+		}
+
+		results = append(results, RepositoryResult{Result: result, Repository: repository, Err: err})
+	}
+
+	return results, errors.Join(errs...)
+}
+
+// Summary formats the results as a Markdown table.
+func Summary(results []RepositoryResult) string {
+	var b strings.Builder
+
+	b.WriteString("| Repository | Pull requests | Processed | Failed | Error |\n")
+	b.WriteString("|:-----------|--------------:|----------:|-------:|:------|\n")
+
+	for _, r := range results {
+		var message string
+		if r.Err != nil {
+			// keep the table intact
+			message = strings.ReplaceAll(strings.ReplaceAll(r.Err.Error(), "\n", " "), "|", `\|`)
+		}
+
+		fmt.Fprintf(&b, "| %s | %d | %d | %d | %s |\n", r.Repository, r.PullRequests, r.Processed, r.Failed, message)
+	}
+
+	return b.String()
+}
@@ -0,0 +1,112 @@
+package scan
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skarlso/caretaker/pkg/client"
+	"github.com/skarlso/caretaker/pkg/client/fakes"
+	"github.com/skarlso/caretaker/pkg/logger"
+)
+
+func TestResolveRepositories(t *testing.T) {
+	tests := []struct {
+		name         string
+		patterns     []string
+		search       string
+		want         []string
+		wantListings int
+		wantErr      string
+	}{
+		{
+			name:     "names aren't looked up",
+			patterns: []string{"caretaker", "api-gateway"},
+			want:     []string{"caretaker", "api-gateway"},
+		},
+		{
+			name:         "glob patterns match the repositories of the owner",
+			patterns:     []string{"API-*", "caretaker", "*-gateway"},
+			want:         []string{"api-gateway", "api-users", "caretaker"},
+			wantListings: 1,
+		},
+		{
+			name:     "search results are added once",
+			patterns: []string{"caretaker"},
+			search:   "topic:caretaker",
+			want:     []string{"caretaker", "website"},
+		},
+		{
+			name:         "nothing matches",
+			patterns:     []string{"missing-*"},
+			wantListings: 1,
+			wantErr:      "no repositories to scan",
+		},
+		{
+			name:         "invalid pattern",
+			patterns:     []string{"api-["},
+			wantListings: 1,
+			wantErr:      "invalid repository pattern api-[",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &fakes.FakeClient{}
+			f.RepositoriesReturns([]string{"api-gateway", "api-users", "caretaker", "website"}, nil)
+			f.SearchRepositoriesReturns([]string{"Caretaker", "website"}, nil)
+
+			got, err := ResolveRepositories(context.Background(), &logger.QuiteLogger{}, f, tt.patterns, tt.search)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+
+			assert.Equal(t, tt.wantListings, f.RepositoriesCallCount())
+		})
+	}
+}
+
+func TestMultiScanner_Scan(t *testing.T) {
+	stale := client.PullRequest{ID: "PR_1", Number: 1, UpdatedAt: githubv4.Date{Time: time.Now().Add(-48 * time.Hour)}}
+	stale.ClosingIssuesReferences.Nodes = append(stale.ClosingIssuesReferences.Nodes, client.Issue{Number: 2})
+
+	clients := map[string]*fakes.FakeClient{
+		"caretaker": {},
+		"website":   {},
+		"archived":  {},
+	}
+	clients["caretaker"].PullRequestsReturns([]client.PullRequest{stale, {ID: "PR_3", Number: 3}}, nil)
+	clients["website"].PullRequestsReturns([]client.PullRequest{stale}, nil)
+	clients["website"].AddLabelReturns(errors.New("boom"))
+	clients["archived"].PullRequestsReturns(nil, client.ErrForbidden)
+
+	scanner := NewMultiScanner(&logger.QuiteLogger{}, func(repository string) client.Client {
+		return clients[repository]
+	}, Options{Interval: 24 * time.Hour, ScanLabel: "caretaker-processed", DisableComments: true})
+
+	results, err := scanner.Scan(context.Background(), []string{"caretaker", "website", "archived"})
+	require.ErrorIs(t, err, client.ErrForbidden)
+	assert.ErrorContains(t, err, "repository website: 1 of 1 task(s) failed")
+
+	assert.Equal(t, []RepositoryResult{
+		{Repository: "caretaker", Result: Result{PullRequests: 2, Processed: 1}},
+		{Repository: "website", Result: Result{PullRequests: 1, Failed: 1}, Err: results[1].Err},
+		{Repository: "archived", Err: results[2].Err},
+	}, results)
+	assert.Equal(t, 1, clients["caretaker"].UpdateIssueStatusCallCount())
+
+	assert.Equal(t, "| Repository | Pull requests | Processed | Failed | Error |\n"+
+		"|:-----------|--------------:|----------:|-------:|:------|\n"+
+		"| caretaker | 2 | 1 | 0 |  |\n"+
+		"| website | 1 | 0 | 1 | 1 of 1 task(s) failed: - pull request 1: failed to add label to processed entity: boom |\n"+
+		"| archived | 0 | 0 | 0 | failed to list pull requests: forbidden |\n",
+		Summary(results))
+}
@@ -0,0 +1,82 @@
+package scan
+
+import (
+	"fmt"
+
+	"github.com/shurcooL/githubv4"
+
+	"github.com/skarlso/caretaker/pkg/client"
+)
+
+// ReviewState is the review state of a pull request which can be mapped to a status.
+type ReviewState string
+
+const (
+	// ReviewStateNone means there is no review and no review has been requested.
+	ReviewStateNone ReviewState = "none"
+	// ReviewStateRequested means a review has been requested and is pending.
+	ReviewStateRequested ReviewState = "review_requested"
+	// ReviewStateChangesRequested means the latest review requested changes.
+	ReviewStateChangesRequested ReviewState = "changes_requested"
+	// ReviewStateCommented means the latest review only left comments.
+	ReviewStateCommented ReviewState = "commented"
+	// ReviewStateApproved means the pull request has been approved.
+	ReviewStateApproved ReviewState = "approved"
+)
+
+// ParseReviewStatuses validates the review states of a review state to status mapping.
+func ParseReviewStatuses(m map[string]string) (map[ReviewState]string, error) {
+	result := make(map[ReviewState]string, len(m))
+
+	for k, v := range m {
+		switch state := ReviewState(k); state {
+		case ReviewStateNone, ReviewStateRequested, ReviewStateChangesRequested, ReviewStateCommented, ReviewStateApproved:
+			result[state] = v
+		default:
+			return nil, fmt.Errorf("unknown review state %s", k)
+		}
+	}
+
+	return result, nil
+}
+
+// reviewState determines the review state of a pull request. An approval wins. After that,
+// a pending review request wins over the latest review, because re-requesting a review
+// means the author addressed the previous one.
+func reviewState(pr client.PullRequest) ReviewState {
+	if pr.ReviewDecision == githubv4.PullRequestReviewDecisionApproved {
+		return ReviewStateApproved
+	}
+
+	if pr.ReviewRequests.TotalCount > 0 {
+		return ReviewStateRequested
+	}
+
+	if pr.ReviewDecision == githubv4.PullRequestReviewDecisionChangesRequested {
+		return ReviewStateChangesRequested
+	}
+
+	// The review decision is only set if the repository requires reviews, so look at the latest review.
+	var latest *client.Review
+
+	for i, review := range pr.LatestReviews.Nodes {
+		if latest == nil || review.SubmittedAt.After(latest.SubmittedAt.Time) {
+			latest = &pr.LatestReviews.Nodes[i]
+		}
+	}
+
+	if latest == nil {
+		return ReviewStateNone
+	}
+
+	switch latest.State {
+	case githubv4.PullRequestReviewStateApproved:
+		return ReviewStateApproved
+	case githubv4.PullRequestReviewStateChangesRequested:
+		return ReviewStateChangesRequested
+	case githubv4.PullRequestReviewStateCommented:
+		return ReviewStateCommented
+	default:
+		return ReviewStateNone
+	}
+}
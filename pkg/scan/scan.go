@@ -2,20 +2,32 @@ package scan
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/shurcooL/githubv4"
 
+	"github.com/skarlso/caretaker/pkg/calendar"
 	"github.com/skarlso/caretaker/pkg/client"
 	"github.com/skarlso/caretaker/pkg/logger"
+	"github.com/skarlso/caretaker/pkg/worker"
 )
 
 type Options struct {
-	Interval        time.Duration
+	Filters
+
+	Interval time.Duration
+	// Calendar measures the interval in working time. If nil, wall-clock time is used.
+	Calendar        *calendar.Calendar
 	ScanLabel       string
 	DisableComments bool
 	StatusName      string
+	// ReviewStatuses routes the issues of a pull request to a status based on the pull request's
+	// review state. If set, pull requests in a review state without a status are skipped.
+	ReviewStatuses map[ReviewState]string
+	// Concurrency is the number of pull requests processed at the same time.
+	Concurrency int
 }
 
 type Scanner struct {
@@ -33,28 +45,46 @@ func NewScanner(log logger.Logger, client client.Client, opts Options) *Scanner
 	}
 }
 
+// Result counts the pull requests of a scan.
+type Result struct {
+	// PullRequests is the number of open pull requests.
+	PullRequests int
+	// Processed is the number of pull requests whose issues have been moved.
+	Processed int
+	// Failed is the number of pull requests which failed to be processed.
+	Failed int
+}
+
 // Scan checks if any associated issues should be moved into a different column based on this PR.
-func (c *Scanner) Scan(ctx context.Context) error {
+func (c *Scanner) Scan(ctx context.Context) (Result, error) {
 	pullRequests, err := c.client.PullRequests(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to list pull requests: %w", err)
+		return Result{}, fmt.Errorf("failed to list pull requests: %w", err)
 	}
 
 	now := time.Now()
-loop:
+
+	var tasks []worker.Task
+
 	for _, pr := range pullRequests {
 		pr := pr
 
-		for _, label := range pr.Labels.Nodes {
-			if string(label.Name) == c.ScanLabel {
-				c.log.Log("pull request with number %d already processed", pr.Number)
-
-				continue loop
+		if c.processed(pr) {
+			if task, ok := c.reroute(pr); ok {
+				tasks = append(tasks, task)
 			}
+
+			continue
+		}
+
+		if reason, skip := c.skip(pr); skip {
+			c.log.Log("skipping pull request with number %d because %s", pr.Number, reason)
+
+			continue
 		}
 
 		// If the last action ( any action ) on the Pull Request is after now, skip it.
-		if pr.UpdatedAt.Add(c.Interval).After(now) {
+		if c.Calendar.Elapsed(pr.UpdatedAt.Time, now) < c.Interval {
 			continue
 		}
 
@@ -64,29 +94,169 @@ loop:
 			continue
 		}
 
-		for _, issue := range pr.ClosingIssuesReferences.Nodes {
-			issue := issue
-			updated, err := c.client.UpdateIssueStatus(ctx, issue, githubv4.String(c.StatusName), -1)
+		status, ok := c.statusFor(pr)
+		if !ok {
+			continue
+		}
+
+		tasks = append(tasks, c.task(pr, status))
+	}
+
+	result := Result{PullRequests: len(pullRequests), Processed: len(tasks)}
+
+	err = worker.NewPool(c.log, c.Concurrency).Run(ctx, tasks)
+
+	var report *worker.Report
+	if errors.As(err, &report) {
+		result.Failed = len(report.Failures)
+		result.Processed -= result.Failed
+	}
+
+	return result, err
+}
+
+// task updates the issues of the pull request before it's labeled as processed, so a pull request
+// with a failed update is picked up again by the next scan. A failed update doesn't stop the updates
+// of the other issues.
+func (c *Scanner) task(pr client.PullRequest, status string) worker.Task {
+	steps := []worker.Step{c.updateIssues(pr.ClosingIssuesReferences.Nodes, status), func(ctx context.Context) error {
+		if err := c.client.AddLabel(ctx, c.ScanLabel, pr.ID); err != nil {
+			return fmt.Errorf("failed to add label to processed entity: %w", err)
+		}
+
+		return nil
+	}, func(ctx context.Context) error {
+		if c.DisableComments {
+			return nil
+		}
+
+		if err := c.client.LeaveComment(ctx, pr.ID, "Pull request successfully processed by Caretaker."); err != nil {
+			c.log.Log("failed to leave comment on pull request %d with error: %s", pr.Number, err)
+			// we continue as everything else seemed to have worked and a comment shouldn't stop the flow
+		}
+
+		return nil
+	}}
+
+	return worker.Task{
+		Name:  fmt.Sprintf("pull request %d", pr.Number),
+		Steps: steps,
+	}
+}
+
+// updateIssues returns a step which moves the issues to the status. A failed update doesn't stop the updates of
+// the other issues.
+func (c *Scanner) updateIssues(issues []client.Issue, status string) worker.Step {
+	return func(ctx context.Context) error {
+		var errs []error
+
+		for _, issue := range issues {
+			result, err := c.client.UpdateIssueStatus(ctx, issue, githubv4.String(status), -1)
 			if err != nil {
-				return fmt.Errorf("failed to mutate issue: %w", err)
+				errs = append(errs, fmt.Errorf("failed to mutate issue %d: %w", issue.Number, err))
+
+				continue
 			}
 
-			if updated {
+			if result.Updated() {
 				c.log.Debug("issue number %d successfully mutated", issue.Number)
 			}
 		}
 
-		if err := c.client.AddLabel(ctx, c.ScanLabel, pr.ID); err != nil {
-			return fmt.Errorf("failed to add label to processed entity: %w", err)
+		return errors.Join(errs...)
+	}
+}
+
+// processed returns whether the pull request has the scan label.
+func (c *Scanner) processed(pr client.PullRequest) bool {
+	for _, label := range pr.Labels.Nodes {
+		if string(label.Name) == c.ScanLabel {
+			return true
 		}
+	}
+
+	return false
+}
+
+// reroute returns a task which moves the issues of a processed pull request if its review state has changed
+// since. Only issues which are in another status of the review state routing are moved, so issues which have
+// been moved by hand are left alone. The interval isn't waited for again and no label or comment is added.
+// Without review state routing, processed pull requests are skipped.
+func (c *Scanner) reroute(pr client.PullRequest) (worker.Task, bool) {
+	if len(c.ReviewStatuses) == 0 {
+		c.log.Log("pull request with number %d already processed", pr.Number)
+
+		return worker.Task{}, false
+	}
+
+	if reason, skip := c.skip(pr); skip {
+		c.log.Log("skipping pull request with number %d because %s", pr.Number, reason)
+
+		return worker.Task{}, false
+	}
+
+	status, ok := c.statusFor(pr)
+	if !ok {
+		return worker.Task{}, false
+	}
+
+	var issues []client.Issue
+
+	for _, issue := range pr.ClosingIssuesReferences.Nodes {
+		if c.routedElsewhere(issue, status) {
+			issues = append(issues, issue)
+		}
+	}
 
-		if !c.DisableComments {
-			if err := c.client.LeaveComment(ctx, pr.ID, "Pull request successfully processed by Caretaker."); err != nil {
-				c.log.Log("failed to leave comment on pull request %d with error: %s", pr.Number, err)
-				// we continue as everything else seemed to have worked and a comment shouldn't stop the flow
+	if len(issues) == 0 {
+		c.log.Log("pull request with number %d already processed", pr.Number)
+
+		return worker.Task{}, false
+	}
+
+	c.log.Log("review state of pull request with number %d has changed, moving %d issues to %s",
+		pr.Number, len(issues), status)
+
+	return worker.Task{
+		Name:  fmt.Sprintf("pull request %d", pr.Number),
+		Steps: []worker.Step{c.updateIssues(issues, status)},
+	}, true
+}
+
+// routedElsewhere returns whether the issue is in a status of the review state routing other than the status.
+func (c *Scanner) routedElsewhere(issue client.Issue, status string) bool {
+	for _, item := range issue.ProjectItems.Nodes {
+		current := string(item.FieldValueByName.ProjectV2SingleSelectField.Name)
+		if current == status {
+			continue
+		}
+
+		for _, routed := range c.ReviewStatuses {
+			if current == routed {
+				return true
 			}
 		}
 	}
 
-	return nil
+	return false
+}
+
+// statusFor returns the status the issues of the pull request should be moved to.
+func (c *Scanner) statusFor(pr client.PullRequest) (string, bool) {
+	if len(c.ReviewStatuses) == 0 {
+		return c.StatusName, true
+	}
+
+	state := reviewState(pr)
+
+	status, ok := c.ReviewStatuses[state]
+	if !ok {
+		c.log.Log("pull request with number %d is in review state %s which has no status, skipping", pr.Number, state)
+
+		return "", false
+	}
+
+	c.log.Debug("pull request with number %d is in review state %s, moving issues to %s", pr.Number, state, status)
+
+	return status, true
 }
@@ -2,14 +2,19 @@ package scan
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/shurcooL/githubv4"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/skarlso/caretaker/pkg/client"
 	"github.com/skarlso/caretaker/pkg/client/fakes"
 	"github.com/skarlso/caretaker/pkg/logger"
+	"github.com/skarlso/caretaker/pkg/recording"
 )
 
 func TestChecker_Check(t *testing.T) {
@@ -45,9 +50,229 @@ func TestChecker_Check(t *testing.T) {
 				client: m,
 				log:    &log,
 			}
-			err := c.Scan(context.Background())
+			_, err := c.Scan(context.Background())
 
 			tt.wantErr(t, err, fmt.Sprintf("check scan pull requests"))
 		})
 	}
 }
+
+func TestScanner_Scan_UpdatesAllIssues(t *testing.T) {
+	pr := client.PullRequest{ID: "PR_1", Number: 1, UpdatedAt: githubv4.Date{Time: time.Now().Add(-48 * time.Hour)}}
+	pr.ClosingIssuesReferences.Nodes = append(pr.ClosingIssuesReferences.Nodes,
+		client.Issue{Number: 2}, client.Issue{Number: 3})
+
+	f := &fakes.FakeClient{}
+	f.PullRequestsReturns([]client.PullRequest{pr}, nil)
+	f.UpdateIssueStatusReturnsOnCall(0, client.StatusResult{}, errors.New("boom"))
+
+	scanner := NewScanner(&logger.QuiteLogger{}, f, Options{Interval: 24 * time.Hour, DisableComments: true})
+
+	result, err := scanner.Scan(context.Background())
+	assert.ErrorContains(t, err, "failed to mutate issue 2: boom")
+	assert.Equal(t, Result{PullRequests: 1, Failed: 1}, result)
+
+	// the other issue is still updated, but the pull request isn't labeled as processed
+	require.Equal(t, 2, f.UpdateIssueStatusCallCount())
+	_, issue, _, _ := f.UpdateIssueStatusArgsForCall(1)
+	assert.Equal(t, githubv4.Int(3), issue.(client.Issue).Number)
+	assert.Equal(t, 0, f.AddLabelCallCount())
+}
+
+func TestScanner_Scan_ReroutesProcessedPullRequests(t *testing.T) {
+	issue := func(number int, status githubv4.String) client.Issue {
+		i := client.Issue{Number: githubv4.Int(number)}
+		item := client.ProjectV2Item{ID: githubv4.String(fmt.Sprintf("PVTI_%d", number))}
+		item.FieldValueByName.ProjectV2SingleSelectField.Name = status
+		i.ProjectItems.Nodes = append(i.ProjectItems.Nodes, item)
+
+		return i
+	}
+
+	tests := []struct {
+		name           string
+		reviewStatuses map[ReviewState]string
+		wantUpdated    []githubv4.Int
+	}{
+		{
+			name: "issues in another routed status are moved",
+			reviewStatuses: map[ReviewState]string{
+				ReviewStateRequested:        "In Review",
+				ReviewStateChangesRequested: "In Progress",
+			},
+			wantUpdated: []githubv4.Int{2},
+		},
+		{
+			name: "processed pull requests are skipped without routing",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// the pull request has been labeled while review was requested, now changes are requested
+			pr := client.PullRequest{
+				ID:             "PR_1",
+				Number:         1,
+				UpdatedAt:      githubv4.Date{Time: time.Now()},
+				ReviewDecision: githubv4.PullRequestReviewDecisionChangesRequested,
+			}
+			pr.Labels.Nodes = append(pr.Labels.Nodes, struct{ Name githubv4.String }{Name: "caretaker-processed"})
+			pr.ClosingIssuesReferences.Nodes = append(pr.ClosingIssuesReferences.Nodes,
+				issue(2, "In Review"), issue(3, "Done"), issue(4, "In Progress"))
+
+			f := &fakes.FakeClient{}
+			f.PullRequestsReturns([]client.PullRequest{pr}, nil)
+
+			scanner := NewScanner(&logger.QuiteLogger{}, f, Options{
+				Interval:       24 * time.Hour,
+				ScanLabel:      "caretaker-processed",
+				StatusName:     "In Review",
+				ReviewStatuses: tt.reviewStatuses,
+			})
+
+			_, err := scanner.Scan(context.Background())
+			require.NoError(t, err)
+
+			// issues moved by hand and issues in the new status are left alone
+			var updated []githubv4.Int
+			for i := range f.UpdateIssueStatusCallCount() {
+				_, issue, status, _ := f.UpdateIssueStatusArgsForCall(i)
+				assert.Equal(t, githubv4.String("In Progress"), status)
+
+				updated = append(updated, issue.(client.Issue).Number)
+			}
+
+			assert.Equal(t, tt.wantUpdated, updated)
+			assert.Equal(t, 0, f.AddLabelCallCount())
+			assert.Equal(t, 0, f.LeaveCommentCallCount())
+		})
+	}
+}
+
+func TestFilters_Skip(t *testing.T) {
+	pr := client.PullRequest{
+		IsDraft:     true,
+		BaseRefName: "release/1.0",
+		HeadRefName: "dependabot/go_modules/foo",
+	}
+	pr.Author.Login = "renovate-bot"
+	pr.Labels.Nodes = append(pr.Labels.Nodes, struct{ Name githubv4.String }{Name: "WIP"})
+
+	tests := []struct {
+		name     string
+		filters  Filters
+		wantSkip bool
+	}{
+		{
+			name: "no filters",
+		},
+		{
+			name:     "drafts",
+			filters:  Filters{SkipDrafts: true},
+			wantSkip: true,
+		},
+		{
+			name:     "excluded label is case-insensitive",
+			filters:  Filters{ExcludeLabels: []string{"wip"}},
+			wantSkip: true,
+		},
+		{
+			name:     "missing required label",
+			filters:  Filters{RequireLabels: []string{"wip", "ready"}},
+			wantSkip: true,
+		},
+		{
+			name:     "excluded author pattern",
+			filters:  Filters{ExcludeAuthors: []string{"renovate*"}},
+			wantSkip: true,
+		},
+		{
+			name:     "base branch not included",
+			filters:  Filters{BaseBranches: []string{"main"}},
+			wantSkip: true,
+		},
+		{
+			name:    "base branch included",
+			filters: Filters{BaseBranches: []string{"main", "release/*"}},
+		},
+		{
+			name:     "excluded head branch",
+			filters:  Filters{ExcludeHeadBranches: []string{"dependabot/*/*"}},
+			wantSkip: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, skip := tt.filters.skip(pr)
+			assert.Equal(t, tt.wantSkip, skip)
+		})
+	}
+}
+
+func TestReviewState(t *testing.T) {
+	older := githubv4.DateTime{Time: time.Now().Add(-time.Hour)}
+	newer := githubv4.DateTime{Time: time.Now()}
+
+	tests := []struct {
+		name  string
+		setup func(pr *client.PullRequest)
+		want  ReviewState
+	}{
+		{
+			name:  "no reviews",
+			setup: func(*client.PullRequest) {},
+			want:  ReviewStateNone,
+		},
+		{
+			name: "approved decision",
+			setup: func(pr *client.PullRequest) {
+				pr.ReviewDecision = githubv4.PullRequestReviewDecisionApproved
+				pr.ReviewRequests.TotalCount = 1
+			},
+			want: ReviewStateApproved,
+		},
+		{
+			name: "pending request wins over changes requested",
+			setup: func(pr *client.PullRequest) {
+				pr.ReviewDecision = githubv4.PullRequestReviewDecisionChangesRequested
+				pr.ReviewRequests.TotalCount = 1
+			},
+			want: ReviewStateRequested,
+		},
+		{
+			name: "latest review without decision",
+			setup: func(pr *client.PullRequest) {
+				pr.LatestReviews.Nodes = []client.Review{
+					{State: githubv4.PullRequestReviewStateChangesRequested, SubmittedAt: newer},
+					{State: githubv4.PullRequestReviewStateApproved, SubmittedAt: older},
+				}
+			},
+			want: ReviewStateChangesRequested,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pr := client.PullRequest{}
+			tt.setup(&pr)
+			assert.Equal(t, tt.want, reviewState(pr))
+		})
+	}
+}
+
+func TestScanner_Scan_Recorded(t *testing.T) {
+	gclient, replayer, err := recording.NewReplayClient("testdata/in-progress-to-in-review")
+	require.NoError(t, err)
+
+	caretaker := client.NewCaretaker(&logger.QuiteLogger{}, gclient, client.Options{Owner: "skarlso", Repo: "caretaker"})
+	scanner := NewScanner(&logger.QuiteLogger{}, caretaker, Options{
+		Interval:    24 * time.Hour,
+		ScanLabel:   "caretaker-processed",
+		StatusName:  "In Review",
+		Concurrency: 1,
+	})
+
+	result, err := scanner.Scan(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, Result{PullRequests: 2, Processed: 1}, result)
+	assert.Empty(t, replayer.Unused())
+}
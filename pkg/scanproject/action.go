@@ -0,0 +1,49 @@
+package scanproject
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Action defines what a transition does with a matching project item.
+type Action string
+
+const (
+	// ActionMove sets the status of the item to the To status of the transition.
+	ActionMove Action = "move"
+	// ActionArchive archives the item.
+	ActionArchive Action = "archive"
+	// ActionDelete removes the item from the project.
+	ActionDelete Action = "delete"
+	// ActionUnarchive restores an archived item and, if To is set, moves it to the To status.
+	ActionUnarchive Action = "unarchive"
+	// ActionConvert converts a draft issue into an issue and, if To is set, moves it to the To status.
+	// Other items are moved as with ActionMove.
+	ActionConvert Action = "convert"
+)
+
+// ParseAction parses an action name. An empty name is ActionMove.
+func ParseAction(s string) (Action, error) {
+	if s == "" {
+		return ActionMove, nil
+	}
+
+	action := Action(strings.ToLower(strings.TrimSpace(s)))
+	switch action {
+	case ActionMove, ActionArchive, ActionDelete, ActionUnarchive, ActionConvert:
+		return action, nil
+	}
+
+	return "", fmt.Errorf("unknown action %s, wanted one of move, archive, delete, unarchive or convert", s)
+}
+
+// RequiresStatus returns whether the action needs a To status.
+func (a Action) RequiresStatus() bool {
+	return a == "" || a == ActionMove
+}
+
+// appliesTo returns whether the action handles archived or active items. Only unarchive
+// works on archived items; every other action ignores them.
+func (a Action) appliesTo(archived bool) bool {
+	return (a == ActionUnarchive) == archived
+}
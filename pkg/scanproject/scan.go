@@ -7,16 +7,41 @@ import (
 
 	"github.com/shurcooL/githubv4"
 
+	"github.com/skarlso/caretaker/pkg/calendar"
 	"github.com/skarlso/caretaker/pkg/client"
+	"github.com/skarlso/caretaker/pkg/filter"
 	"github.com/skarlso/caretaker/pkg/logger"
+	"github.com/skarlso/caretaker/pkg/worker"
 )
 
+// Transition applies the Action to items which have been sitting in the From status for longer than
+// Interval. Moving an item sets its status to To.
+type Transition struct {
+	From     string
+	To       string
+	Interval time.Duration
+	// WorkingTime measures the Interval with the Calendar of the scanner instead of wall-clock time.
+	WorkingTime bool
+	// Action defaults to ActionMove.
+	Action Action
+	// Repository is where ActionConvert creates the issues, either owner/name or name. Defaults to
+	// the client's repository.
+	Repository string
+}
+
 type Options struct {
-	ProjectNumber   int
-	Interval        time.Duration
+	ProjectNumber int
+	// Transitions are evaluated in order; the first transition with a matching From status is used.
+	Transitions []Transition
+	// Calendar measures the intervals of transitions with WorkingTime. If nil, wall-clock time is used.
+	Calendar *calendar.Calendar
+	// StalenessBasis defines what the intervals are measured from. Defaults to StalenessStatus.
+	StalenessBasis StalenessBasis
+	// Filter limits the scan to the matching project items. If nil, all items are scanned.
+	Filter *filter.Filter
+	// Concurrency is the number of items processed at the same time.
+	Concurrency     int
 	DisableComments bool
-	FromStatus      string
-	ToStatus        string
 }
 
 type Scanner struct {
@@ -34,60 +59,196 @@ func NewScanner(log logger.Logger, client client.Client, opts Options) *Scanner
 	}
 }
 
-// ScanIssues checks if any issues of a project should be moved into a different column based on ToStatus.
+// ScanIssues checks if any issues of a project should be moved into a different column based on the transitions.
+// All transitions are evaluated against a single fetch of the project items.
 func (c *Scanner) ScanIssues(ctx context.Context) error {
 	now := time.Now()
 
-	items, err := c.client.ProjectItems(ctx, c.ProjectNumber)
+	// The API hides archived items from filtered queries, so the filter is only passed on if no transition
+	// handles archived items. In either case, it's evaluated on the returned items.
+	query := c.Filter.String()
+	if c.handlesArchived() {
+		query = ""
+	}
+
+	items, err := c.client.ProjectItems(ctx, c.ProjectNumber, query)
 	if err != nil {
 		return err
 	}
 
+	var me string
+
+	if c.Filter.UsesMe() {
+		if me, err = c.client.ViewerLogin(ctx); err != nil {
+			return err
+		}
+	}
+
 	c.log.Log("updating %d items", len(items))
 
+	var tasks []worker.Task
+
 	for _, item := range items {
-		if v := item.FieldValueByName.ProjectV2SingleSelectField.Name; v != githubv4.String(c.FromStatus) {
-			c.log.Log("skipping issue %s; status %s doesn't match with %s", item.Content.Issue.Title, v, c.FromStatus)
+		if !c.Filter.Match(item, me) {
+			continue
+		}
+
+		status := item.FieldValueByName.ProjectV2SingleSelectField.Name
+
+		transition, ok := c.transitionFor(string(status), bool(item.IsArchived))
+		if !ok {
+			c.log.Log("skipping issue %s; no transition defined from status %s", item.Content.Issue.Title, status)
 
 			continue
 		}
 
-		// If the last action ( any action ) on the issue is after now, skip it.
-		if item.UpdatedAt.Add(c.Interval).After(now) {
+		// If the last change of the staleness basis doesn't exceed the interval, skip it.
+		if lastChange := c.lastChange(item); c.elapsed(transition, lastChange, now) < transition.Interval {
 			c.log.Log(
 				"issue %s has been last updated at %s which doesn't exceed the interval %s",
 				item.Content.Issue.Title,
-				item.UpdatedAt.Format(time.RFC3339),
-				c.Interval,
+				lastChange.Format(time.RFC3339),
+				transition.Interval,
 			)
 
 			continue
 		}
 
-		if item.Type == client.IssueType {
-			c.log.Log("updating issues with title %s", item.Content.Issue.Title)
-
-			if _, err := c.client.UpdateIssueStatus(
-				ctx,
-				item.Content.Issue,
-				githubv4.String(c.ToStatus),
-				c.ProjectNumber,
-			); err != nil {
-				return fmt.Errorf("failed to update issue: %w", err)
-			}
-		} else if item.Type == client.PullRequestType {
-			c.log.Log("updating pull request with title %s", item.Content.PullRequest.Number)
-
-			if _, err := c.client.UpdateIssueStatus(
-				ctx,
-				item.Content.PullRequest,
-				githubv4.String(c.ToStatus),
-				c.ProjectNumber,
-			); err != nil {
-				return fmt.Errorf("failed to update issue: %w", err)
-			}
+		tasks = append(tasks, worker.Task{
+			Name: fmt.Sprintf("project item %s", item.ID),
+			Steps: []worker.Step{func(ctx context.Context) error {
+				return c.apply(ctx, item, transition)
+			}},
+		})
+	}
+
+	return worker.NewPool(c.log, c.Concurrency).Run(ctx, tasks)
+}
+
+// elapsed returns the time between from and to, in working time if the transition asks for it.
+func (c *Scanner) elapsed(transition Transition, from, to time.Time) time.Duration {
+	if !transition.WorkingTime {
+		return to.Sub(from)
+	}
+
+	return c.Calendar.Elapsed(from, to)
+}
+
+// apply runs the action of the transition on the item.
+func (c *Scanner) apply(ctx context.Context, item client.ProjectV2ItemWithIssueContent, transition Transition) error {
+	projectID, itemID := githubv4.ID(item.Project.ID), githubv4.ID(item.ID)
+
+	switch transition.Action {
+	case ActionArchive:
+		c.log.Log("archiving project item with id %s", item.ID)
+
+		if err := c.client.ArchiveProjectItem(ctx, projectID, itemID); err != nil {
+			return fmt.Errorf("failed to archive item: %w", err)
+		}
+
+		return nil
+	case ActionDelete:
+		c.log.Log("deleting project item with id %s", item.ID)
+
+		if err := c.client.DeleteProjectItem(ctx, projectID, itemID); err != nil {
+			return fmt.Errorf("failed to delete item: %w", err)
+		}
+
+		return nil
+	case ActionUnarchive:
+		c.log.Log("unarchiving project item with id %s", item.ID)
+
+		if err := c.client.UnarchiveProjectItem(ctx, projectID, itemID); err != nil {
+			return fmt.Errorf("failed to unarchive item: %w", err)
+		}
+
+		if transition.To == "" {
+			return nil
+		}
+	case ActionConvert:
+		if item.Type != client.DraftIssueType {
+			break
+		}
+
+		c.log.Log("converting draft issue with title %s", item.Content.DraftIssue.Title)
+
+		issue, err := c.client.ConvertDraftIssue(ctx, itemID, transition.Repository)
+		if err != nil {
+			return fmt.Errorf("failed to convert draft issue: %w", err)
+		}
+
+		if transition.To == "" {
+			return nil
+		}
+
+		if _, err := c.client.UpdateIssueStatus(ctx, issue, githubv4.String(transition.To), c.ProjectNumber); err != nil {
+			return fmt.Errorf("failed to update issue: %w", err)
+		}
+
+		return nil
+	}
+
+	return c.move(ctx, item, transition.To)
+}
+
+// move sets the status of the item's issue or pull request.
+func (c *Scanner) move(ctx context.Context, item client.ProjectV2ItemWithIssueContent, status string) error {
+	if item.Type == client.IssueType {
+		c.log.Log("updating issues with title %s", item.Content.Issue.Title)
+
+		if _, err := c.client.UpdateIssueStatus(
+			ctx,
+			item.Content.Issue,
+			githubv4.String(status),
+			c.ProjectNumber,
+		); err != nil {
+			return fmt.Errorf("failed to update issue: %w", err)
+		}
+	} else if item.Type == client.PullRequestType {
+		c.log.Log("updating pull request with title %s", item.Content.PullRequest.Number)
+
+		if _, err := c.client.UpdateIssueStatus(
+			ctx,
+			item.Content.PullRequest,
+			githubv4.String(status),
+			c.ProjectNumber,
+		); err != nil {
+			return fmt.Errorf("failed to update issue: %w", err)
+		}
+	} else if item.Type == client.DraftIssueType {
+		c.log.Log("updating draft issue with title %s", item.Content.DraftIssue.Title)
+
+		if _, err := c.client.UpdateIssueStatus(
+			ctx,
+			item.Content.DraftIssue,
+			githubv4.String(status),
+			c.ProjectNumber,
+		); err != nil {
+			return fmt.Errorf("failed to update draft issue: %w", err)
 		}
 	}
 
 	return nil
 }
+
+// handlesArchived returns whether any of the transitions applies to archived items.
+func (c *Scanner) handlesArchived() bool {
+	for _, t := range c.Transitions {
+		if t.Action.appliesTo(true) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// transitionFor returns the first transition from the status which handles items with the given archived state.
+func (c *Scanner) transitionFor(status string, archived bool) (Transition, bool) {
+	for _, t := range c.Transitions {
+		if t.From == status && t.Action.appliesTo(archived) {
+			return t, true
+		}
+	}
+
+	return Transition{}, false
+}
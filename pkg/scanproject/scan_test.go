@@ -0,0 +1,261 @@
+package scanproject
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skarlso/caretaker/pkg/calendar"
+	"github.com/skarlso/caretaker/pkg/client"
+	"github.com/skarlso/caretaker/pkg/client/fakes"
+	"github.com/skarlso/caretaker/pkg/filter"
+	"github.com/skarlso/caretaker/pkg/logger"
+	"github.com/skarlso/caretaker/pkg/recording"
+)
+
+func projectItem(id, status string, archived bool, age time.Duration) client.ProjectV2ItemWithIssueContent {
+	item := client.ProjectV2ItemWithIssueContent{
+		ID:         githubv4.String(id),
+		Type:       client.IssueType,
+		UpdatedAt:  githubv4.Date{Time: time.Now().Add(-age)},
+		IsArchived: githubv4.Boolean(archived),
+	}
+	item.Project.ID = "project"
+	item.FieldValueByName.ProjectV2SingleSelectField.Name = githubv4.String(status)
+
+	return item
+}
+
+func TestScanner_ScanIssues(t *testing.T) {
+	const day = 24 * time.Hour
+
+	f := &fakes.FakeClient{}
+	f.ProjectItemsReturns([]client.ProjectV2ItemWithIssueContent{
+		projectItem("done-old", "Done", false, 30*day),
+		projectItem("done-new", "Done", false, day),
+		projectItem("review-old", "In Review", false, 10*day),
+		projectItem("blocked-old", "Blocked", false, 40*day),
+		projectItem("archived-old", "Done", true, 60*day),
+		projectItem("todo-old", "Todo", false, 60*day),
+	}, nil)
+
+	scanner := NewScanner(&logger.QuiteLogger{}, f, Options{
+		ProjectNumber: 1,
+		Transitions: []Transition{
+			{From: "Done", Interval: 14 * day, Action: ActionArchive},
+			{From: "In Review", To: "Stale", Interval: 7 * day},
+			{From: "Blocked", Interval: 30 * day, Action: ActionDelete},
+			{From: "Done", To: "Triage", Interval: 50 * day, Action: ActionUnarchive},
+		},
+	})
+
+	require.NoError(t, scanner.ScanIssues(context.Background()))
+
+	assert.Equal(t, 1, f.ProjectItemsCallCount())
+
+	require.Equal(t, 1, f.ArchiveProjectItemCallCount())
+	_, projectID, itemID := f.ArchiveProjectItemArgsForCall(0)
+	assert.Equal(t, githubv4.ID(githubv4.String("project")), projectID)
+	assert.Equal(t, githubv4.ID(githubv4.String("done-old")), itemID)
+
+	require.Equal(t, 1, f.DeleteProjectItemCallCount())
+	_, _, itemID = f.DeleteProjectItemArgsForCall(0)
+	assert.Equal(t, githubv4.ID(githubv4.String("blocked-old")), itemID)
+
+	require.Equal(t, 1, f.UnarchiveProjectItemCallCount())
+	_, _, itemID = f.UnarchiveProjectItemArgsForCall(0)
+	assert.Equal(t, githubv4.ID(githubv4.String("archived-old")), itemID)
+
+	// In Review is moved to Stale and the unarchived item is moved to Triage.
+	require.Equal(t, 2, f.UpdateIssueStatusCallCount())
+	_, _, status, _ := f.UpdateIssueStatusArgsForCall(0)
+	assert.Equal(t, githubv4.String("Stale"), status)
+	_, _, status, _ = f.UpdateIssueStatusArgsForCall(1)
+	assert.Equal(t, githubv4.String("Triage"), status)
+}
+
+func TestScanner_ScanIssues_WorkingTime(t *testing.T) {
+	const day = 24 * time.Hour
+
+	office, err := calendar.New(calendar.Options{WorkingHours: "09:00-10:00"})
+	require.NoError(t, err)
+
+	review := projectItem("review", "In Review", false, 2*day)
+	review.Content.Issue.Title = "review"
+	todo := projectItem("todo", "Todo", false, 2*day)
+	todo.Content.Issue.Title = "todo"
+
+	f := &fakes.FakeClient{}
+	f.ProjectItemsReturns([]client.ProjectV2ItemWithIssueContent{review, todo}, nil)
+
+	scanner := NewScanner(&logger.QuiteLogger{}, f, Options{
+		ProjectNumber: 1,
+		Transitions: []Transition{
+			// wall-clock time isn't affected by the calendar
+			{From: "In Review", To: "Stale", Interval: day},
+			// two days have at most three working hours
+			{From: "Todo", To: "Stale", Interval: day, WorkingTime: true},
+		},
+		Calendar: office,
+	})
+
+	require.NoError(t, scanner.ScanIssues(context.Background()))
+
+	require.Equal(t, 1, f.UpdateIssueStatusCallCount())
+	_, issue, _, _ := f.UpdateIssueStatusArgsForCall(0)
+	assert.Equal(t, githubv4.String("review"), issue.GetTitle())
+}
+
+func TestScanner_ScanIssues_Filter(t *testing.T) {
+	const day = 24 * time.Hour
+
+	tests := []struct {
+		name          string
+		transition    Transition
+		wantQuery     string
+		wantArchived  int
+		wantUnarchive int
+	}{
+		{
+			name:         "the filter is passed to the API",
+			transition:   Transition{From: "Done", Interval: 14 * day, Action: ActionArchive},
+			wantQuery:    "status:Done",
+			wantArchived: 1,
+		},
+		{
+			name:          "unarchive transitions fetch all items and filter them",
+			transition:    Transition{From: "Done", To: "Triage", Interval: 50 * day, Action: ActionUnarchive},
+			wantQuery:     "",
+			wantUnarchive: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &fakes.FakeClient{}
+			f.ProjectItemsReturns([]client.ProjectV2ItemWithIssueContent{
+				projectItem("done-old", "Done", false, 60*day),
+				projectItem("archived-old", "Done", true, 60*day),
+				projectItem("review-old", "In Review", true, 60*day),
+			}, nil)
+
+			done, err := filter.Parse("status:Done")
+			require.NoError(t, err)
+
+			scanner := NewScanner(&logger.QuiteLogger{}, f, Options{
+				ProjectNumber: 1,
+				Filter:        done,
+				Transitions:   []Transition{tt.transition},
+			})
+
+			require.NoError(t, scanner.ScanIssues(context.Background()))
+
+			require.Equal(t, 1, f.ProjectItemsCallCount())
+			_, _, query := f.ProjectItemsArgsForCall(0)
+			assert.Equal(t, tt.wantQuery, query)
+			assert.Equal(t, tt.wantArchived, f.ArchiveProjectItemCallCount())
+			require.Equal(t, tt.wantUnarchive, f.UnarchiveProjectItemCallCount())
+
+			if tt.wantUnarchive > 0 {
+				_, _, itemID := f.UnarchiveProjectItemArgsForCall(0)
+				assert.Equal(t, githubv4.ID(githubv4.String("archived-old")), itemID)
+			}
+		})
+	}
+}
+
+func TestScanner_LastChange(t *testing.T) {
+	itemUpdated := time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)
+	statusUpdated := time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC)
+	contentUpdated := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	item := client.ProjectV2ItemWithIssueContent{
+		Type:      client.IssueType,
+		UpdatedAt: githubv4.Date{Time: itemUpdated},
+	}
+	item.FieldValueByName.ProjectV2SingleSelectField.UpdatedAt = githubv4.DateTime{Time: statusUpdated}
+	item.Content.Issue.UpdatedAt = githubv4.Date{Time: contentUpdated}
+
+	tests := []struct {
+		basis StalenessBasis
+		item  client.ProjectV2ItemWithIssueContent
+		want  time.Time
+	}{
+		{basis: StalenessStatus, item: item, want: statusUpdated},
+		{basis: StalenessItem, item: item, want: itemUpdated},
+		{basis: StalenessContent, item: item, want: contentUpdated},
+		{basis: StalenessStatus, item: client.ProjectV2ItemWithIssueContent{
+			UpdatedAt: githubv4.Date{Time: itemUpdated},
+		}, want: itemUpdated},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.basis), func(t *testing.T) {
+			scanner := NewScanner(&logger.QuiteLogger{}, &fakes.FakeClient{}, Options{StalenessBasis: tt.basis})
+
+			assert.Equal(t, tt.want, scanner.lastChange(tt.item))
+		})
+	}
+}
+
+func TestScanner_ScanIssues_DraftIssues(t *testing.T) {
+	const day = 24 * time.Hour
+
+	draft := func(id, status string) client.ProjectV2ItemWithIssueContent {
+		item := projectItem(id, status, false, 30*day)
+		item.Type = client.DraftIssueType
+		item.Content.DraftIssue.Title = githubv4.String(id)
+
+		return item
+	}
+
+	f := &fakes.FakeClient{}
+	f.ProjectItemsReturns([]client.ProjectV2ItemWithIssueContent{
+		draft("draft-todo", "Todo"),
+		draft("draft-backlog", "Backlog"),
+	}, nil)
+	f.ConvertDraftIssueReturns(client.Issue{Title: "converted"}, nil)
+
+	scanner := NewScanner(&logger.QuiteLogger{}, f, Options{
+		ProjectNumber: 1,
+		Transitions: []Transition{
+			{From: "Todo", To: "Stale", Interval: 14 * day},
+			{From: "Backlog", To: "Triage", Interval: 14 * day, Action: ActionConvert, Repository: "org/repo"},
+		},
+	})
+
+	require.NoError(t, scanner.ScanIssues(context.Background()))
+
+	require.Equal(t, 1, f.ConvertDraftIssueCallCount())
+	_, itemID, repository := f.ConvertDraftIssueArgsForCall(0)
+	assert.Equal(t, githubv4.ID(githubv4.String("draft-backlog")), itemID)
+	assert.Equal(t, "org/repo", repository)
+
+	require.Equal(t, 2, f.UpdateIssueStatusCallCount())
+	_, issue, status, _ := f.UpdateIssueStatusArgsForCall(0)
+	assert.Equal(t, githubv4.String("draft-todo"), issue.GetTitle())
+	assert.Equal(t, githubv4.String("Stale"), status)
+	_, issue, status, _ = f.UpdateIssueStatusArgsForCall(1)
+	assert.Equal(t, githubv4.String("converted"), issue.GetTitle())
+	assert.Equal(t, githubv4.String("Triage"), status)
+}
+
+// TestScanner_ScanIssues_Recorded replays a recorded run: only the item which has been done for long enough is
+// closed, the item which has been in progress for longer isn't touched.
+func TestScanner_ScanIssues_Recorded(t *testing.T) {
+	gclient, replayer, err := recording.NewReplayClient("testdata/done-to-closed")
+	require.NoError(t, err)
+
+	caretaker := client.NewCaretaker(&logger.QuiteLogger{}, gclient, client.Options{Owner: "skarlso", Repo: "caretaker"})
+	scanner := NewScanner(&logger.QuiteLogger{}, caretaker, Options{
+		ProjectNumber: 1,
+		Transitions:   []Transition{{From: "Done", To: "Closed", Interval: 48 * time.Hour}},
+	})
+
+	require.NoError(t, scanner.ScanIssues(context.Background()))
+	assert.Empty(t, replayer.Unused())
+}
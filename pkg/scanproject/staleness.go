@@ -0,0 +1,64 @@
+package scanproject
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/skarlso/caretaker/pkg/client"
+)
+
+// StalenessBasis defines which timestamp the interval of a transition is measured from.
+type StalenessBasis string
+
+const (
+	// StalenessStatus measures the time since the status of the item has been set.
+	StalenessStatus StalenessBasis = "status"
+	// StalenessItem measures the time since the item has been updated. Editing any field
+	// of the item, for example, Priority, resets it.
+	StalenessItem StalenessBasis = "item"
+	// StalenessContent measures the time since the issue or pull request has been updated.
+	StalenessContent StalenessBasis = "content"
+)
+
+// ParseStalenessBasis parses a staleness basis. An empty basis is StalenessStatus.
+func ParseStalenessBasis(s string) (StalenessBasis, error) {
+	if s == "" {
+		return StalenessStatus, nil
+	}
+
+	basis := StalenessBasis(strings.ToLower(strings.TrimSpace(s)))
+	switch basis {
+	case StalenessStatus, StalenessItem, StalenessContent:
+		return basis, nil
+	}
+
+	return "", fmt.Errorf("unknown staleness basis %s, wanted one of status, item or content", s)
+}
+
+// lastChange returns the timestamp the staleness of the item is measured from. If the
+// timestamp of the basis isn't available, the item's updated time is used.
+func (c *Scanner) lastChange(item client.ProjectV2ItemWithIssueContent) time.Time {
+	var t time.Time
+
+	switch c.StalenessBasis {
+	case StalenessItem:
+	case StalenessContent:
+		switch item.Type {
+		case client.IssueType:
+			t = item.Content.Issue.UpdatedAt.Time
+		case client.PullRequestType:
+			t = item.Content.PullRequest.UpdatedAt.Time
+		case client.DraftIssueType:
+			t = item.Content.DraftIssue.UpdatedAt.Time
+		}
+	default:
+		t = item.FieldValueByName.ProjectV2SingleSelectField.UpdatedAt.Time
+	}
+
+	if t.IsZero() {
+		return item.UpdatedAt.Time
+	}
+
+	return t
+}
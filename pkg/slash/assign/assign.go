@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/shurcooL/githubv4"
+
 	"github.com/skarlso/caretaker/pkg/client"
 	"github.com/skarlso/caretaker/pkg/slash"
 )
@@ -36,20 +38,43 @@ func (h *Handler) Execute(ctx context.Context, pullNumber int, actor string, _ .
 	}
 
 	// assign user to the PR
-	if err := h.client.AssignUserToAssignable(ctx, user.ID, pr.ID); err != nil {
-		return fmt.Errorf("failed to assign user to pull request: %w", err)
+	if !pr.Assignees.Has(user.ID) {
+		if err := h.client.AssignUserToAssignable(ctx, user.ID, pr.ID); err != nil {
+			return fmt.Errorf("failed to assign user to pull request: %w", err)
+		}
+
+		record(ctx, user.ID, pr.ID, pr.Number)
 	}
 
 	// assign user to all related issues
 	for _, issue := range pr.ClosingIssuesReferences.Nodes {
+		if issue.Assignees.Has(user.ID) {
+			continue
+		}
+
 		if err := h.client.AssignUserToAssignable(ctx, user.ID, issue.ID); err != nil {
 			return fmt.Errorf("failed to assign user to issue: %w", err)
 		}
+
+		record(ctx, user.ID, issue.ID, issue.Number)
 	}
 
 	return nil
 }
 
-func (h *Handler) Help() string {
-	return "- `/assign` assign this pull request and all attached issues to the actor"
+func record(ctx context.Context, userID, subjectID githubv4.ID, number githubv4.Int) {
+	slash.Record(ctx, slash.Change{
+		Kind:          slash.ChangeAssignee,
+		SubjectID:     fmt.Sprint(subjectID),
+		SubjectNumber: int(number),
+		UserID:        fmt.Sprint(userID),
+	})
+}
+
+func (h *Handler) Metadata() slash.Metadata {
+	return slash.Metadata{
+		Name:        Command,
+		Description: "assign this pull request and all attached issues to the actor",
+		Examples:    []string{"/assign"},
+	}
 }
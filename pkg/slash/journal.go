@@ -0,0 +1,132 @@
+package slash
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+const (
+	// journalMarker starts the hidden marker which contains the recorded changes of a comment.
+	journalMarker = "<!-- caretaker-journal: "
+	// revertedMarker replaces journalMarker once the changes have been reverted.
+	revertedMarker = "<!-- caretaker-journal-reverted: "
+	markerEnd      = " -->"
+)
+
+// ChangeKind defines what kind of mutation a Change recorded.
+type ChangeKind string
+
+const (
+	// ChangeStatus is a status update of an issue on a project.
+	ChangeStatus ChangeKind = "status"
+	// ChangeLabel is a label added to an object.
+	ChangeLabel ChangeKind = "label"
+	// ChangeAssignee is a user assigned to an object.
+	ChangeAssignee ChangeKind = "assignee"
+)
+
+// Change is the before-state of a single mutation performed by a command.
+type Change struct {
+	Kind ChangeKind `json:"kind"`
+	// SubjectID is the node ID of the mutated issue or pull request.
+	SubjectID string `json:"subjectId"`
+	// SubjectNumber is the number of the mutated issue or pull request.
+	SubjectNumber int `json:"subjectNumber"`
+	// ProjectNumber and FromStatus are set for status changes.
+	ProjectNumber int    `json:"projectNumber,omitempty"`
+	FromStatus    string `json:"fromStatus,omitempty"`
+	// Label is set for label changes.
+	Label string `json:"label,omitempty"`
+	// UserID is set for assignee changes.
+	UserID string `json:"userId,omitempty"`
+}
+
+// Journal collects the changes of all commands run from a single comment.
+type Journal struct {
+	Commands []string `json:"commands"`
+	Actor    string   `json:"actor"`
+	Changes  []Change `json:"changes"`
+
+	mu sync.Mutex
+}
+
+type journalKey struct{}
+
+// WithJournal returns a context which records changes into the given journal.
+func WithJournal(ctx context.Context, journal *Journal) context.Context {
+	return context.WithValue(ctx, journalKey{}, journal)
+}
+
+// Record adds a change to the journal of the context. It's a no-op if the context has no journal.
+func Record(ctx context.Context, change Change) {
+	journal, ok := ctx.Value(journalKey{}).(*Journal)
+	if !ok {
+		return
+	}
+
+	journal.mu.Lock()
+	defer journal.mu.Unlock()
+
+	journal.Changes = append(journal.Changes, change)
+}
+
+// Render creates the comment which stores the journal in a hidden marker.
+func (j *Journal) Render() (string, error) {
+	// json.Marshal escapes < and > so the content can't terminate the HTML comment.
+	content, err := json.Marshal(j)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal journal: %w", err)
+	}
+
+	return fmt.Sprintf(
+		"Caretaker recorded %d change(s) made by %s. Use `/undo` to revert them.\n\n%s%s%s",
+		len(j.Changes),
+		strings.Join(j.Commands, ", "),
+		journalMarker,
+		content,
+		markerEnd,
+	), nil
+}
+
+// ParseJournal extracts the journal from a comment body. It returns false if the body
+// doesn't contain a journal that hasn't been reverted yet.
+func ParseJournal(body string) (*Journal, bool, error) {
+	start := strings.Index(body, journalMarker)
+	if start == -1 {
+		return nil, false, nil
+	}
+
+	content := body[start+len(journalMarker):]
+
+	end := strings.Index(content, markerEnd)
+	if end == -1 {
+		return nil, false, errors.New("journal marker is not terminated")
+	}
+
+	journal := &Journal{}
+	if err := json.Unmarshal([]byte(content[:end]), journal); err != nil {
+		return nil, false, fmt.Errorf("failed to unmarshal journal: %w", err)
+	}
+
+	return journal, true, nil
+}
+
+// MarkReverted returns the comment body with the journal marked as reverted, so it's not reverted twice.
+func MarkReverted(body, actor string) string {
+	start := strings.Index(body, journalMarker)
+	if start == -1 {
+		return body
+	}
+
+	return fmt.Sprintf(
+		"~~%s~~ Reverted by @%s.\n\n%s%s",
+		strings.TrimSpace(body[:start]),
+		actor,
+		revertedMarker,
+		body[start+len(journalMarker):],
+	)
+}
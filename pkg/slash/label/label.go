@@ -0,0 +1,87 @@
+package label
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/skarlso/caretaker/pkg/client"
+	"github.com/skarlso/caretaker/pkg/slash"
+)
+
+// Command defines the command this handler understands.
+const Command = "/label"
+
+type Handler struct {
+	client client.Client
+}
+
+func NewHandler(client client.Client) *Handler {
+	return &Handler{
+		client: client,
+	}
+}
+
+var _ slash.Command = &Handler{}
+
+// Execute adds the given labels to the pull request.
+func (h *Handler) Execute(ctx context.Context, pullNumber int, _ string, args ...string) error {
+	var labels []string
+
+	for _, arg := range args {
+		if label := strings.TrimSpace(arg); label != "" {
+			labels = append(labels, label)
+		}
+	}
+
+	if len(labels) == 0 {
+		return errors.New("at least one label is required, none was given")
+	}
+
+	pr, err := h.client.PullRequest(ctx, pullNumber)
+	if err != nil {
+		return fmt.Errorf("failed to get related pull request: %w", err)
+	}
+
+	for _, label := range labels {
+		if hasLabel(pr, label) {
+			continue
+		}
+
+		if err := h.client.AddLabel(ctx, label, pr.ID); err != nil {
+			return fmt.Errorf("failed to add label %s to pull request: %w", label, err)
+		}
+
+		slash.Record(ctx, slash.Change{
+			Kind:          slash.ChangeLabel,
+			SubjectID:     fmt.Sprint(pr.ID),
+			SubjectNumber: int(pr.Number),
+			Label:         label,
+		})
+	}
+
+	return nil
+}
+
+func hasLabel(pr client.PullRequest, label string) bool {
+	for _, l := range pr.Labels.Nodes {
+		if strings.EqualFold(string(l.Name), label) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (h *Handler) Metadata() slash.Metadata {
+	return slash.Metadata{
+		Name:        Command,
+		Description: "add labels to this pull request",
+		Usage:       "/label <label>[,<label>...]",
+		Arguments: []slash.Argument{
+			{Name: "label", Description: "comma separated list of existing labels", Required: true},
+		},
+		Examples: []string{"/label ready", "/label ready,needs docs"},
+	}
+}
@@ -0,0 +1,232 @@
+package link
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/skarlso/caretaker/pkg/client"
+	"github.com/skarlso/caretaker/pkg/slash"
+)
+
+const (
+	// Command defines the command the link handler understands.
+	Command = "/link"
+	// UnlinkCommand defines the command the unlink handler understands.
+	UnlinkCommand = "/unlink"
+
+	// closingKeyword is the keyword Caretaker uses when it adds a reference to the body.
+	closingKeyword = "Closes"
+)
+
+// closingKeywords are the keywords GitHub understands for linking a pull request to an issue.
+// https://docs.github.com/en/issues/tracking-your-work-with-issues/linking-a-pull-request-to-an-issue
+const closingKeywords = `close|closes|closed|fix|fixes|fixed|resolve|resolves|resolved`
+
+// reference matches #12 or owner/repo#12.
+const reference = `(?:[\w.-]+/[\w.-]+)?#\d+`
+
+var (
+	// referenceRegex matches an argument which is a single reference.
+	referenceRegex = regexp.MustCompile(`^` + reference + `$`)
+	// closingRegex matches a closing keyword followed by a reference.
+	closingRegex = regexp.MustCompile(`(?i)\b(?:` + closingKeywords + `):?\s+(` + reference + `)\b`)
+	// closingLineRegex matches a line which only consists of a closing keyword and a reference.
+	closingLineRegex = regexp.MustCompile(
+		`(?im)^[ \t]*(?:` + closingKeywords + `):?[ \t]+(` + reference + `)[ \t]*(?:\r?\n|$)`,
+	)
+)
+
+type Handler struct {
+	client     client.Client
+	repository string
+}
+
+// NewHandler creates a handler for the pull requests of the repository, given as owner/repo. References
+// to issues of the repository are the same with and without the owner and repository.
+func NewHandler(client client.Client, repository string) *Handler {
+	return &Handler{
+		client:     client,
+		repository: repository,
+	}
+}
+
+var _ slash.Command = &Handler{}
+
+// Execute adds a closing keyword for every given issue reference to the body of the pull request.
+// This makes GitHub track the issues in the pull request's closing issue references.
+func (h *Handler) Execute(ctx context.Context, pullNumber int, _ string, args ...string) error {
+	refs, err := parseReferences(args...)
+	if err != nil {
+		return err
+	}
+
+	pr, err := h.client.PullRequest(ctx, pullNumber)
+	if err != nil {
+		return fmt.Errorf("failed to get related pull request: %w", err)
+	}
+
+	body := string(pr.Body)
+	updated := body
+
+	for _, ref := range refs {
+		if linked(updated, ref, h.repository) {
+			continue
+		}
+
+		if updated != "" && !strings.HasSuffix(updated, "\n") {
+			updated += "\n"
+		}
+
+		updated += fmt.Sprintf("%s %s\n", closingKeyword, ref)
+	}
+
+	if updated == body {
+		return nil
+	}
+
+	if err := h.client.UpdatePullRequestBody(ctx, pr.ID, updated); err != nil {
+		return fmt.Errorf("failed to link issues to pull request: %w", err)
+	}
+
+	return nil
+}
+
+func (h *Handler) Metadata() slash.Metadata {
+	return slash.Metadata{
+		Name:        Command,
+		Description: "link issues to this pull request by adding closing keywords to its description",
+		Usage:       "/link #<number>|<owner>/<repo>#<number> ...",
+		Arguments: []slash.Argument{
+			{Name: "reference", Description: "space separated list of issue references", Required: true},
+		},
+		Examples: []string{"/link #12", "/link #12 org/other#34"},
+	}
+}
+
+type UnlinkHandler struct {
+	client     client.Client
+	repository string
+}
+
+// NewUnlinkHandler creates a handler for the pull requests of the repository, given as owner/repo.
+func NewUnlinkHandler(client client.Client, repository string) *UnlinkHandler {
+	return &UnlinkHandler{
+		client:     client,
+		repository: repository,
+	}
+}
+
+var _ slash.Command = &UnlinkHandler{}
+
+// Execute removes the closing keywords for every given issue reference from the body of the pull request.
+// The reference itself is left in the text, only the keyword is removed.
+func (h *UnlinkHandler) Execute(ctx context.Context, pullNumber int, _ string, args ...string) error {
+	refs, err := parseReferences(args...)
+	if err != nil {
+		return err
+	}
+
+	pr, err := h.client.PullRequest(ctx, pullNumber)
+	if err != nil {
+		return fmt.Errorf("failed to get related pull request: %w", err)
+	}
+
+	body := string(pr.Body)
+	updated := body
+
+	for _, ref := range refs {
+		updated = unlink(updated, ref, h.repository)
+	}
+
+	if updated == body {
+		return nil
+	}
+
+	if err := h.client.UpdatePullRequestBody(ctx, pr.ID, updated); err != nil {
+		return fmt.Errorf("failed to unlink issues from pull request: %w", err)
+	}
+
+	return nil
+}
+
+func (h *UnlinkHandler) Metadata() slash.Metadata {
+	return slash.Metadata{
+		Name:        UnlinkCommand,
+		Description: "remove the closing keywords of issues from this pull request's description",
+		Usage:       "/unlink #<number>|<owner>/<repo>#<number> ...",
+		Arguments: []slash.Argument{
+			{Name: "reference", Description: "space separated list of issue references", Required: true},
+		},
+		Examples: []string{"/unlink org/other#34"},
+	}
+}
+
+// parseReferences splits the arguments on whitespace and validates each issue reference.
+func parseReferences(args ...string) ([]string, error) {
+	var refs []string
+
+	for _, arg := range args {
+		for _, ref := range strings.Fields(arg) {
+			if !referenceRegex.MatchString(ref) {
+				return nil, fmt.Errorf("invalid issue reference %s, wanted #number or owner/repo#number", ref)
+			}
+
+			refs = append(refs, ref)
+		}
+	}
+
+	if len(refs) == 0 {
+		return nil, errors.New("at least one issue reference is required, none was given")
+	}
+
+	return refs, nil
+}
+
+// sameReference returns whether the references point to the same issue. A reference without owner and
+// repository points to an issue of the repository.
+func sameReference(a, b, repository string) bool {
+	qualify := func(ref string) string {
+		if strings.HasPrefix(ref, "#") {
+			return repository + ref
+		}
+
+		return ref
+	}
+
+	return strings.EqualFold(qualify(a), qualify(b))
+}
+
+// linked returns whether the body has a closing keyword for the reference.
+func linked(body, ref, repository string) bool {
+	for _, match := range closingRegex.FindAllStringSubmatch(body, -1) {
+		if sameReference(match[1], ref, repository) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// unlink removes lines which only consist of a closing keyword and the reference. If the reference
+// is part of a longer sentence, only the keyword is removed.
+func unlink(body, ref, repository string) string {
+	body = replaceReferences(closingLineRegex, body, ref, repository, func(string) string { return "" })
+
+	return replaceReferences(closingRegex, body, ref, repository, func(match string) string { return match })
+}
+
+// replaceReferences replaces the matches of the regular expression whose reference, the first group, is the
+// same as ref with the result of replace, which is called with the reference as written in the body.
+func replaceReferences(re *regexp.Regexp, body, ref, repository string, replace func(string) string) string {
+	return re.ReplaceAllStringFunc(body, func(match string) string {
+		written := re.FindStringSubmatch(match)[1]
+		if !sameReference(written, ref, repository) {
+			return match
+		}
+
+		return replace(written)
+	})
+}
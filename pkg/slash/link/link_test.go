@@ -0,0 +1,113 @@
+package link
+
+import (
+	"context"
+	"testing"
+
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skarlso/caretaker/pkg/client"
+	"github.com/skarlso/caretaker/pkg/client/fakes"
+)
+
+func TestHandler_Execute(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		args     []string
+		wantBody string
+		wantErr  assert.ErrorAssertionFunc
+	}{
+		{
+			name:     "appends closing keywords",
+			body:     "Some description.",
+			args:     []string{"#12 org/other#34"},
+			wantBody: "Some description.\nCloses #12\nCloses org/other#34\n",
+			wantErr:  assert.NoError,
+		},
+		{
+			name:    "already linked",
+			body:    "Fixes #12",
+			args:    []string{"#12"},
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "already linked with owner and repository",
+			body:    "Closes Skarlso/caretaker#12",
+			args:    []string{"#12"},
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "invalid reference",
+			args:    []string{"12"},
+			wantErr: assert.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &fakes.FakeClient{}
+			f.PullRequestReturns(client.PullRequest{ID: "PR_1", Body: githubv4.String(tt.body)}, nil)
+
+			err := NewHandler(f, "skarlso/caretaker").Execute(context.Background(), 1, "actor", tt.args...)
+			tt.wantErr(t, err)
+
+			if tt.wantBody == "" {
+				assert.Equal(t, 0, f.UpdatePullRequestBodyCallCount())
+
+				return
+			}
+
+			require.Equal(t, 1, f.UpdatePullRequestBodyCallCount())
+			_, id, body := f.UpdatePullRequestBodyArgsForCall(0)
+			assert.Equal(t, "PR_1", id)
+			assert.Equal(t, tt.wantBody, body)
+		})
+	}
+}
+
+func TestUnlink(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		ref  string
+		want string
+	}{
+		{
+			name: "removes whole line",
+			body: "Description.\nFixes #12\nCloses #123\n",
+			ref:  "#12",
+			want: "Description.\nCloses #123\n",
+		},
+		{
+			name: "removes keyword inside a sentence",
+			body: "This resolves: org/other#34 and more.",
+			ref:  "org/other#34",
+			want: "This org/other#34 and more.",
+		},
+		{
+			name: "does not touch other repositories",
+			body: "Fixes org/other#12",
+			ref:  "#12",
+			want: "Fixes org/other#12",
+		},
+		{
+			name: "matches references to the repository with and without owner",
+			body: "Description.\nCloses skarlso/caretaker#12\nSee #12, it fixes #12.",
+			ref:  "#12",
+			want: "Description.\nSee #12, it #12.",
+		},
+		{
+			name: "does not match longer numbers",
+			body: "Fixes #123",
+			ref:  "skarlso/caretaker#12",
+			want: "Fixes #123",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, unlink(tt.body, tt.ref, "skarlso/caretaker"))
+		})
+	}
+}
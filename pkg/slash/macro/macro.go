@@ -0,0 +1,121 @@
+package macro
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/shurcooL/githubv4"
+
+	"github.com/skarlso/caretaker/pkg/config"
+	"github.com/skarlso/caretaker/pkg/slash"
+)
+
+// maxDepth limits how deep custom commands can call other custom commands.
+const maxDepth = 5
+
+type depthKey struct{}
+
+// data is passed to the templates of the step arguments.
+type data struct {
+	Actor      string
+	PullNumber int
+	Args       map[string]string
+}
+
+type Handler struct {
+	command    config.Command
+	dispatcher slash.Dispatcher
+}
+
+// NewHandler creates a handler which runs the steps of a custom command through the dispatcher.
+func NewHandler(command config.Command, dispatcher slash.Dispatcher) *Handler {
+	return &Handler{
+		command:    command,
+		dispatcher: dispatcher,
+	}
+}
+
+var _ slash.Command = &Handler{}
+
+// Name returns the command name with which this handler should be registered.
+func (h *Handler) Name() string {
+	return "/" + strings.TrimPrefix(h.command.Name, "/")
+}
+
+// Execute runs every step of the custom command in order.
+func (h *Handler) Execute(ctx context.Context, pullNumber int, actor string, args ...string) error {
+	depth, _ := ctx.Value(depthKey{}).(int)
+	if depth >= maxDepth {
+		return errors.New("maximum depth of nested custom commands reached")
+	}
+
+	ctx = context.WithValue(ctx, depthKey{}, depth+1)
+
+	argMap, err := slash.ConvertArgs(args...)
+	if err != nil {
+		return fmt.Errorf("failed to convert arguments to command: %w", err)
+	}
+
+	d := data{
+		Actor:      actor,
+		PullNumber: pullNumber,
+		Args:       argMap,
+	}
+
+	for _, step := range h.command.Steps {
+		stepArgs := make([]string, 0, len(step.Args))
+
+		for _, arg := range step.Args {
+			rendered, err := render(arg, d)
+			if err != nil {
+				return fmt.Errorf("failed to render argument %q of step %s: %w", arg, step.Command, err)
+			}
+
+			stepArgs = append(stepArgs, rendered)
+		}
+
+		if err := h.dispatcher.Dispatch(ctx, pullNumber, actor, step.Command, stepArgs...); err != nil {
+			return fmt.Errorf("step %s of %s failed: %w", step.Command, h.Name(), err)
+		}
+	}
+
+	return nil
+}
+
+func (h *Handler) Metadata() slash.Metadata {
+	arguments := make([]slash.Argument, 0, len(h.command.Arguments))
+	for _, arg := range h.command.Arguments {
+		arguments = append(arguments, slash.Argument{
+			Name:        arg.Name,
+			Description: arg.Description,
+			Required:    arg.Required,
+		})
+	}
+
+	return slash.Metadata{
+		Name:         h.Name(),
+		Description:  h.command.Description,
+		Usage:        h.command.Usage,
+		Arguments:    arguments,
+		Examples:     h.command.Examples,
+		RequiredRole: githubv4.RepositoryPermission(strings.ToUpper(h.command.Role)),
+	}
+}
+
+func render(arg string, d data) (string, error) {
+	tmpl, err := template.New("arg").Option("missingkey=error").Parse(arg)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse template: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, d); err != nil {
+		return "", fmt.Errorf("failed to execute template: %w", err)
+	}
+
+	return buf.String(), nil
+}
@@ -0,0 +1,67 @@
+package macro
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/skarlso/caretaker/pkg/config"
+)
+
+type call struct {
+	cmd  string
+	args []string
+}
+
+type fakeDispatcher struct {
+	calls []call
+}
+
+func (f *fakeDispatcher) Dispatch(_ context.Context, _ int, _, cmd string, args ...string) error {
+	f.calls = append(f.calls, call{cmd: cmd, args: args})
+
+	return nil
+}
+
+func TestHandler_Execute(t *testing.T) {
+	command := config.Command{
+		Name: "ready",
+		Steps: []config.Step{
+			{Command: "/status", Args: []string{"status={{ .Args.status }}"}},
+			{Command: "/request-review", Args: []string{"@{{ .Actor }}"}},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		args      []string
+		wantCalls []call
+		wantErr   assert.ErrorAssertionFunc
+	}{
+		{
+			name: "renders arguments and runs every step",
+			args: []string{"status=In Review"},
+			wantCalls: []call{
+				{cmd: "/status", args: []string{"status=In Review"}},
+				{cmd: "/request-review", args: []string{"@skarlso"}},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "missing argument",
+			wantErr: assert.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dispatcher := &fakeDispatcher{}
+			h := NewHandler(command, dispatcher)
+
+			err := h.Execute(context.Background(), 1, "skarlso", tt.args...)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.wantCalls, dispatcher.calls)
+			assert.Equal(t, "/ready", h.Name())
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package slash
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/shurcooL/githubv4"
+)
+
+// Argument describes a single argument a command understands.
+type Argument struct {
+	Name        string
+	Description string
+	Required    bool
+}
+
+// Metadata describes a command for the `/help` output and for permission checks.
+type Metadata struct {
+	// Name of the command including the leading slash. Defaults to the key the command is registered with.
+	Name        string
+	Description string
+	// Usage shows the shape of the command, for example, `/status status=<name>`.
+	Usage     string
+	Arguments []Argument
+	Examples  []string
+	// RequiredRole is the minimum permission the actor needs on the repository.
+	// Empty means anyone who can comment can run the command.
+	RequiredRole githubv4.RepositoryPermission
+}
+
+// roleRanks orders the repository permissions from least to most privileged.
+var roleRanks = map[githubv4.RepositoryPermission]int{
+	githubv4.RepositoryPermissionRead:     1,
+	githubv4.RepositoryPermissionTriage:   2,
+	githubv4.RepositoryPermissionWrite:    3,
+	githubv4.RepositoryPermissionMaintain: 4,
+	githubv4.RepositoryPermissionAdmin:    5,
+}
+
+// sufficient returns whether the given permission satisfies the required role.
+func sufficient(permission, required githubv4.RepositoryPermission) bool {
+	if required == "" {
+		return true
+	}
+
+	return roleRanks[permission] >= roleRanks[required]
+}
+
+// renderTable lists all commands as a Markdown table sorted by their name.
+func renderTable(commands map[string]Command) string {
+	keys := make([]string, 0, len(commands))
+	for key := range commands {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	var builder strings.Builder
+
+	builder.WriteString("| Command | Description | Usage | Required role |\n")
+	builder.WriteString("|---------|-------------|-------|---------------|\n")
+
+	for _, key := range keys {
+		meta := commands[key].Metadata()
+		fmt.Fprintf(
+			&builder,
+			"| `%s` | %s | `%s` | %s |\n",
+			name(key, meta),
+			escapeCell(meta.Description),
+			escapeCell(usage(key, meta)),
+			role(meta.RequiredRole),
+		)
+	}
+
+	return builder.String()
+}
+
+// renderDetails describes a single command with all its arguments and examples.
+func renderDetails(key string, meta Metadata) string {
+	var builder strings.Builder
+
+	fmt.Fprintf(&builder, "### `%s`\n\n%s\n\n", name(key, meta), meta.Description)
+	fmt.Fprintf(&builder, "**Usage:** `%s`\n\n", usage(key, meta))
+
+	if len(meta.Arguments) > 0 {
+		builder.WriteString("**Arguments:**\n\n")
+		builder.WriteString("| Name | Required | Description |\n")
+		builder.WriteString("|------|----------|-------------|\n")
+
+		for _, arg := range meta.Arguments {
+			required := "no"
+			if arg.Required {
+				required = "yes"
+			}
+
+			fmt.Fprintf(&builder, "| `%s` | %s | %s |\n", arg.Name, required, escapeCell(arg.Description))
+		}
+
+		builder.WriteString("\n")
+	}
+
+	if len(meta.Examples) > 0 {
+		builder.WriteString("**Examples:**\n\n")
+
+		for _, example := range meta.Examples {
+			fmt.Fprintf(&builder, "- `%s`\n", example)
+		}
+
+		builder.WriteString("\n")
+	}
+
+	fmt.Fprintf(&builder, "**Required role:** %s\n", role(meta.RequiredRole))
+
+	return builder.String()
+}
+
+func name(key string, meta Metadata) string {
+	if meta.Name == "" {
+		return key
+	}
+
+	return meta.Name
+}
+
+func usage(key string, meta Metadata) string {
+	if meta.Usage == "" {
+		return name(key, meta)
+	}
+
+	return meta.Usage
+}
+
+func role(r githubv4.RepositoryPermission) string {
+	if r == "" {
+		return "any"
+	}
+
+	return strings.ToLower(string(r))
+}
+
+func escapeCell(s string) string {
+	return strings.ReplaceAll(s, "|", "\\|")
+}
@@ -0,0 +1,32 @@
+package slash_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skarlso/caretaker/pkg/client"
+	"github.com/skarlso/caretaker/pkg/logger"
+	"github.com/skarlso/caretaker/pkg/recording"
+	"github.com/skarlso/caretaker/pkg/slash"
+	"github.com/skarlso/caretaker/pkg/slash/label"
+	"github.com/skarlso/caretaker/pkg/slash/status"
+)
+
+func TestSlash_Run_Recorded(t *testing.T) {
+	gclient, replayer, err := recording.NewReplayClient("testdata/status-and-label")
+	require.NoError(t, err)
+
+	caretaker := client.NewCaretaker(&logger.QuiteLogger{}, gclient, client.Options{Owner: "skarlso", Repo: "caretaker"})
+	s := slash.NewSlashHandler(&logger.QuiteLogger{}, caretaker)
+	s.RegisterHandler(status.Command, status.NewHandler(caretaker))
+	s.RegisterHandler(label.Command, label.NewHandler(caretaker))
+
+	require.NoError(t, s.Run(context.Background(), 2, "maintainer", slash.Comment{
+		ID:   "IC_14",
+		Body: "/status status=In Progress\n/label bug",
+	}))
+	assert.Empty(t, replayer.Unused())
+}
@@ -0,0 +1,88 @@
+package review
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/shurcooL/githubv4"
+
+	"github.com/skarlso/caretaker/pkg/client"
+	"github.com/skarlso/caretaker/pkg/slash"
+)
+
+// Command defines the command this handler understands.
+const Command = "/request-review"
+
+type Handler struct {
+	client client.Client
+}
+
+func NewHandler(client client.Client) *Handler {
+	return &Handler{
+		client: client,
+	}
+}
+
+var _ slash.Command = &Handler{}
+
+// Execute requests a review from the given users and teams. Teams are defined as @org/team-slug.
+func (h *Handler) Execute(ctx context.Context, pullNumber int, _ string, args ...string) error {
+	var reviewers []string
+
+	for _, arg := range args {
+		for _, reviewer := range strings.Fields(arg) {
+			reviewers = append(reviewers, strings.TrimPrefix(reviewer, "@"))
+		}
+	}
+
+	if len(reviewers) == 0 {
+		return errors.New("at least one reviewer is required, none was given")
+	}
+
+	pr, err := h.client.PullRequest(ctx, pullNumber)
+	if err != nil {
+		return fmt.Errorf("failed to get related pull request: %w", err)
+	}
+
+	var userIDs, teamIDs []githubv4.ID
+
+	for _, reviewer := range reviewers {
+		if org, slug, ok := strings.Cut(reviewer, "/"); ok {
+			team, err := h.client.Team(ctx, org, slug)
+			if err != nil {
+				return fmt.Errorf("failed to fetch team %s: %w", reviewer, err)
+			}
+
+			teamIDs = append(teamIDs, team.ID)
+
+			continue
+		}
+
+		user, err := h.client.User(ctx, reviewer)
+		if err != nil {
+			return fmt.Errorf("failed to fetch user %s: %w", reviewer, err)
+		}
+
+		userIDs = append(userIDs, user.ID)
+	}
+
+	if err := h.client.RequestReviews(ctx, pr.ID, userIDs, teamIDs); err != nil {
+		return fmt.Errorf("failed to request reviews: %w", err)
+	}
+
+	return nil
+}
+
+func (h *Handler) Metadata() slash.Metadata {
+	return slash.Metadata{
+		Name:        Command,
+		Description: "request a review from users and teams",
+		Usage:       "/request-review @<user>|@<org>/<team> ...",
+		Arguments: []slash.Argument{
+			{Name: "reviewer", Description: "space separated list of users and teams", Required: true},
+		},
+		Examples: []string{"/request-review @skarlso @org/maintainers"},
+	}
+}
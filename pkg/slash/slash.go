@@ -8,6 +8,7 @@ import (
 	"github.com/shurcooL/githubv4"
 
 	"github.com/skarlso/caretaker/pkg/client"
+	"github.com/skarlso/caretaker/pkg/logger"
 )
 
 const Help = "/help"
@@ -17,48 +18,109 @@ type Command interface {
 	// cmd can be used for further parsing arguments to the command.
 	// GraphQL object https://docs.github.com/en/graphql/reference/objects#issuecomment
 	Execute(ctx context.Context, pullNumber int, actor string, args ...string) error
-	Help() string
+	// Metadata describes the command for `/help` and defines who is allowed to run it.
+	Metadata() Metadata
+}
+
+// Dispatcher can run registered commands by name.
+type Dispatcher interface {
+	Dispatch(ctx context.Context, pullNumber int, actor, cmd string, args ...string) error
+}
+
+// The actions of the issue_comment event.
+// https://docs.github.com/en/actions/using-workflows/events-that-trigger-workflows#issue_comment
+const (
+	ActionCreated = "created"
+	ActionEdited  = "edited"
+	ActionDeleted = "deleted"
+)
+
+// Comment is the comment that triggered the slash command run.
+type Comment struct {
+	// ID is the node ID of the comment.
+	ID string
+	// Action is the action of the issue_comment event. Empty is treated like created.
+	Action string
+	Body   string
+	// PreviousBody is the body before an edit. Only set for edited events.
+	PreviousBody string
 }
 
 type Slash struct {
 	supportedCommands map[string]Command
 	client            client.Client
+	log               logger.Logger
 }
 
-func NewSlashHandler(client client.Client) *Slash {
+func NewSlashHandler(log logger.Logger, client client.Client) *Slash {
 	return &Slash{
 		supportedCommands: make(map[string]Command),
 		client:            client,
+		log:               log,
 	}
 }
 
+// Make sure Slash can be used to dispatch commands.
+var _ Dispatcher = &Slash{}
+
 func (s *Slash) RegisterHandler(key string, cmd Command) {
 	s.supportedCommands[key] = cmd
 }
 
-// Run runs a command parsed from a comment body.
-// Every line is examined to be a possible command.
-func (s *Slash) Run(ctx context.Context, pullNumber int, actor, commentID, commentBody string) error {
-	split := strings.Split(commentBody, "\n")
+// HasHandler returns whether a handler is already registered for the given command.
+func (s *Slash) HasHandler(key string) bool {
+	_, ok := s.supportedCommands[key]
 
-	seen := false
+	return ok
+}
 
-	for _, cmd := range split {
-		if cmd == "" || !strings.HasPrefix(cmd, "/") {
-			continue
-		}
+// Run runs the commands parsed from a comment body.
+// Every line is examined to be a possible command. Running the same comment twice is safe:
+// a comment Caretaker already reacted to with a thumbs up is not executed again, and on edits
+// only the newly added commands are executed. Commands removed by an edit or a deletion are ignored.
+func (s *Slash) Run(ctx context.Context, pullNumber int, actor string, comment Comment) error {
+	if comment.Action == ActionDeleted {
+		s.log.Log("comment %s was deleted, nothing to do", comment.ID)
 
-		// add an eye if we found at least ONE command that can be executed.
-		if !seen {
-			if err := s.client.AddReaction(ctx, commentID, githubv4.ReactionContentEyes); err != nil {
-				return fmt.Errorf("failed to add reaction to comment: %w", err)
-			}
+		return nil
+	}
 
-			seen = true
-		}
+	processed, err := s.client.ViewerHasReacted(ctx, comment.ID, githubv4.ReactionContentThumbsUp)
+	if err != nil {
+		return fmt.Errorf("failed to check if comment was already processed: %w", err)
+	}
+
+	commands := parseCommands(comment.Body)
 
+	switch {
+	case comment.Action == ActionEdited && processed:
+		// the original comment already ran, only run what was added by the edit
+		commands = added(commands, parseCommands(comment.PreviousBody))
+	case processed:
+		s.log.Log("comment %s was already processed, skipping", comment.ID)
+
+		return nil
+	}
+
+	if len(commands) == 0 {
+		s.log.Debug("no new commands found in comment %s", comment.ID)
+
+		return nil
+	}
+
+	// add an eye if we found at least ONE command that can be executed.
+	if err := s.client.AddReaction(ctx, comment.ID, githubv4.ReactionContentEyes); err != nil {
+		return fmt.Errorf("failed to add reaction to comment: %w", err)
+	}
+
+	journal := &Journal{Actor: actor}
+	ctx = WithJournal(ctx, journal)
+
+	for _, cmd := range commands {
 		var args []string
 
+		journal.Commands = append(journal.Commands, cmd)
+
 		if i := strings.Index(cmd, " "); i > -1 {
 			// skip the first one as that's the command
 			arg := cmd[i+1:]
@@ -67,18 +129,22 @@ func (s *Slash) Run(ctx context.Context, pullNumber int, actor, commentID, comme
 			cmd = cmd[0:i]
 		}
 
-		handler, ok := s.supportedCommands[cmd]
-		if !ok {
-			return fmt.Errorf("command handler not registered for command %s", cmd)
-		}
+		if err := s.Dispatch(ctx, pullNumber, actor, cmd, args...); err != nil {
+			// record what has been done so far, so it can be undone
+			if jerr := s.saveJournal(ctx, pullNumber, journal); jerr != nil {
+				s.log.Log("failed to save journal: %s", jerr)
+			}
 
-		if err := handler.Execute(ctx, pullNumber, actor, args...); err != nil {
-			return fmt.Errorf("failed to run command: %w", err)
+			return err
 		}
 	}
 
+	if err := s.saveJournal(ctx, pullNumber, journal); err != nil {
+		return err
+	}
+
 	// add a thumbs up if all commands ran successfully
-	if err := s.client.AddReaction(ctx, commentID, githubv4.ReactionContentThumbsUp); err != nil {
+	if err := s.client.AddReaction(ctx, comment.ID, githubv4.ReactionContentThumbsUp); err != nil {
 		return fmt.Errorf("failed to add reaction to comment: %w", err)
 	}
 
@@ -86,13 +152,119 @@ func (s *Slash) Run(ctx context.Context, pullNumber int, actor, commentID, comme
 	return nil
 }
 
-func (s *Slash) Execute(ctx context.Context, pullNumber int, actor string, _ ...string) error {
-	helpComment := []byte(fmt.Sprintf(`@%s: The following commands are available:
-`, actor))
+// saveJournal leaves a comment with the recorded changes so `/undo` can revert them.
+func (s *Slash) saveJournal(ctx context.Context, pullNumber int, journal *Journal) error {
+	if len(journal.Changes) == 0 {
+		return nil
+	}
+
+	body, err := journal.Render()
+	if err != nil {
+		return err
+	}
+
+	pr, err := s.client.PullRequest(ctx, pullNumber)
+	if err != nil {
+		return fmt.Errorf("failed to fetch pull request with number %d to leave journal on: %w", pullNumber, err)
+	}
+
+	if err := s.client.LeaveComment(ctx, pr.ID, body); err != nil {
+		return fmt.Errorf("failed to leave journal comment: %w", err)
+	}
+
+	return nil
+}
+
+// parseCommands returns every line of the body which starts with a slash.
+func parseCommands(body string) []string {
+	var commands []string
 
-	for _, cmd := range s.supportedCommands {
-		helpComment = append(helpComment, []byte(cmd.Help())...)
-		helpComment = append(helpComment, []byte("\n")...)
+	for _, line := range strings.Split(body, "\n") {
+		line = strings.TrimSpace(line)
+		if !strings.HasPrefix(line, "/") {
+			continue
+		}
+
+		commands = append(commands, line)
+	}
+
+	return commands
+}
+
+// added returns the commands which are not in previous. A command that appears twice in
+// commands but only once in previous is considered added once.
+func added(commands, previous []string) []string {
+	counts := make(map[string]int, len(previous))
+	for _, cmd := range previous {
+		counts[cmd]++
+	}
+
+	var result []string
+
+	for _, cmd := range commands {
+		if counts[cmd] > 0 {
+			counts[cmd]--
+
+			continue
+		}
+
+		result = append(result, cmd)
+	}
+
+	return result
+}
+
+// Dispatch runs the handler registered for the given command with the given arguments.
+func (s *Slash) Dispatch(ctx context.Context, pullNumber int, actor, cmd string, args ...string) error {
+	handler, ok := s.supportedCommands[cmd]
+	if !ok {
+		return fmt.Errorf("command handler not registered for command %s", cmd)
+	}
+
+	if required := handler.Metadata().RequiredRole; required != "" {
+		permission, err := s.client.RepositoryPermission(ctx, actor)
+		if err != nil {
+			return fmt.Errorf("failed to fetch permission of %s: %w", actor, err)
+		}
+
+		if !sufficient(permission, required) {
+			return fmt.Errorf(
+				"%s requires at least %s permission, but %s has %s",
+				cmd,
+				role(required),
+				actor,
+				role(permission),
+			)
+		}
+	}
+
+	if err := handler.Execute(ctx, pullNumber, actor, args...); err != nil {
+		return fmt.Errorf("failed to run command %s: %w", cmd, err)
+	}
+
+	return nil
+}
+
+// Execute leaves a comment with a table of all available commands. If a command is given
+// as an argument, only the detailed description of that command is shown.
+func (s *Slash) Execute(ctx context.Context, pullNumber int, actor string, args ...string) error {
+	var helpComment string
+
+	if len(args) > 0 && strings.TrimSpace(args[0]) != "" {
+		key := "/" + strings.TrimPrefix(strings.TrimSpace(args[0]), "/")
+
+		cmd, ok := s.supportedCommands[key]
+		if !ok {
+			return fmt.Errorf("no help available for unknown command %s", key)
+		}
+
+		helpComment = fmt.Sprintf("@%s:\n\n%s", actor, renderDetails(key, cmd.Metadata()))
+	} else {
+		helpComment = fmt.Sprintf(
+			"@%s: The following commands are available:\n\n%s",
+			actor,
+			renderTable(s.supportedCommands),
+		)
 	}
 
 	pr, err := s.client.PullRequest(ctx, pullNumber)
@@ -100,9 +272,17 @@ func (s *Slash) Execute(ctx context.Context, pullNumber int, actor string, _ ...
 		return fmt.Errorf("failed to fetch pull request with number %d to leave comment on: %w", pullNumber, err)
 	}
 
-	return s.client.LeaveComment(ctx, pr.ID, string(helpComment))
+	return s.client.LeaveComment(ctx, pr.ID, helpComment)
 }
 
-func (s *Slash) Help() string {
-	return "- `/help` returns all available commands"
+func (s *Slash) Metadata() Metadata {
+	return Metadata{
+		Name:        Help,
+		Description: "returns all available commands or the details of a single command",
+		Usage:       "/help [command]",
+		Arguments: []Argument{
+			{Name: "command", Description: "the command to show the details of"},
+		},
+		Examples: []string{"/help", "/help /status"},
+	}
 }
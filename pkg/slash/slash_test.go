@@ -0,0 +1,132 @@
+package slash
+
+import (
+	"context"
+	"testing"
+
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skarlso/caretaker/pkg/client"
+	"github.com/skarlso/caretaker/pkg/client/fakes"
+	"github.com/skarlso/caretaker/pkg/logger"
+)
+
+type fakeCommand struct {
+	meta     Metadata
+	executed int
+}
+
+func (f *fakeCommand) Execute(_ context.Context, _ int, _ string, _ ...string) error {
+	f.executed++
+
+	return nil
+}
+
+func (f *fakeCommand) Metadata() Metadata {
+	return f.meta
+}
+
+func TestSlash_Help(t *testing.T) {
+	f := &fakes.FakeClient{}
+	f.PullRequestReturns(client.PullRequest{ID: "PR_1"}, nil)
+
+	s := NewSlashHandler(&logger.QuiteLogger{}, f)
+	s.RegisterHandler("/zeta", &fakeCommand{meta: Metadata{Description: "last"}})
+	s.RegisterHandler("/alpha", &fakeCommand{meta: Metadata{
+		Description:  "first | with pipe",
+		Usage:        "/alpha key=<value>",
+		Arguments:    []Argument{{Name: "key", Description: "some key", Required: true}},
+		Examples:     []string{"/alpha key=value"},
+		RequiredRole: githubv4.RepositoryPermissionWrite,
+	}})
+	s.RegisterHandler(Help, s)
+
+	require.NoError(t, s.Execute(context.Background(), 1, "skarlso"))
+	_, _, comment := f.LeaveCommentArgsForCall(0)
+	assert.Equal(t, "@skarlso: The following commands are available:\n\n"+
+		"| Command | Description | Usage | Required role |\n"+
+		"|---------|-------------|-------|---------------|\n"+
+		"| `/alpha` | first \\| with pipe | `/alpha key=<value>` | write |\n"+
+		"| `/help` | returns all available commands or the details of a single command | `/help [command]` | any |\n"+
+		"| `/zeta` | last | `/zeta` | any |\n", comment)
+
+	require.NoError(t, s.Execute(context.Background(), 1, "skarlso", "alpha"))
+	_, _, comment = f.LeaveCommentArgsForCall(1)
+	assert.Equal(t, "@skarlso:\n\n### `/alpha`\n\nfirst | with pipe\n\n"+
+		"**Usage:** `/alpha key=<value>`\n\n"+
+		"**Arguments:**\n\n| Name | Required | Description |\n|------|----------|-------------|\n"+
+		"| `key` | yes | some key |\n\n"+
+		"**Examples:**\n\n- `/alpha key=value`\n\n"+
+		"**Required role:** write\n", comment)
+
+	assert.Error(t, s.Execute(context.Background(), 1, "skarlso", "/unknown"))
+}
+
+func TestSlash_DispatchChecksRole(t *testing.T) {
+	cmd := &fakeCommand{meta: Metadata{RequiredRole: githubv4.RepositoryPermissionWrite}}
+
+	f := &fakes.FakeClient{}
+	f.RepositoryPermissionReturnsOnCall(0, githubv4.RepositoryPermissionTriage, nil)
+	f.RepositoryPermissionReturnsOnCall(1, githubv4.RepositoryPermissionAdmin, nil)
+
+	s := NewSlashHandler(&logger.QuiteLogger{}, f)
+	s.RegisterHandler("/cmd", cmd)
+
+	assert.Error(t, s.Dispatch(context.Background(), 1, "skarlso", "/cmd"))
+	assert.Equal(t, 0, cmd.executed)
+	assert.NoError(t, s.Dispatch(context.Background(), 1, "skarlso", "/cmd"))
+	assert.Equal(t, 1, cmd.executed)
+}
+
+func TestSlash_Run(t *testing.T) {
+	tests := []struct {
+		name         string
+		comment      Comment
+		processed    bool
+		wantExecuted int
+	}{
+		{
+			name:         "new comment runs every command",
+			comment:      Comment{ID: "IC_1", Action: ActionCreated, Body: "/cmd\r\nsome text\n/cmd"},
+			wantExecuted: 2,
+		},
+		{
+			name:      "already processed comment is skipped",
+			comment:   Comment{ID: "IC_1", Action: ActionCreated, Body: "/cmd"},
+			processed: true,
+		},
+		{
+			name: "edit only runs added commands",
+			comment: Comment{
+				ID:           "IC_1",
+				Action:       ActionEdited,
+				Body:         "/cmd\n/other",
+				PreviousBody: "/cmd\n/removed",
+			},
+			processed:    true,
+			wantExecuted: 1,
+		},
+		{
+			name:    "deleted comment is ignored",
+			comment: Comment{ID: "IC_1", Action: ActionDeleted, Body: "/cmd"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := &fakeCommand{}
+			other := &fakeCommand{}
+
+			f := &fakes.FakeClient{}
+			f.ViewerHasReactedReturns(tt.processed, nil)
+
+			s := NewSlashHandler(&logger.QuiteLogger{}, f)
+			s.RegisterHandler("/cmd", cmd)
+			s.RegisterHandler("/other", other)
+
+			require.NoError(t, s.Run(context.Background(), 1, "skarlso", tt.comment))
+			assert.Equal(t, tt.wantExecuted, cmd.executed+other.executed)
+		})
+	}
+}
@@ -29,7 +29,7 @@ func NewHandler(client client.Client) *Handler {
 
 var _ slash.Command = &Handler{}
 
-// Execute assigns the pull request and all related issues to the user.
+// Execute sets the status of all related issues.
 func (h *Handler) Execute(ctx context.Context, pullNumber int, _ string, args ...string) error {
 	pr, err := h.client.PullRequest(ctx, pullNumber)
 	if err != nil {
@@ -51,15 +51,38 @@ func (h *Handler) Execute(ctx context.Context, pullNumber int, _ string, args ..
 	}
 
 	for _, issue := range pr.ClosingIssuesReferences.Nodes {
-		if _, err := h.client.UpdateIssueStatus(ctx, issue, githubv4.String(status), -1); err != nil {
+		result, err := h.client.UpdateIssueStatus(ctx, issue, githubv4.String(status), -1)
+		if err != nil {
 			return fmt.Errorf("failed to update issue into desired state %s: %w", status, err)
 		}
+
+		// Only the projects which have been updated are recorded, so undo doesn't touch the others.
+		for _, project := range result.Projects {
+			if project.Outcome != client.StatusUpdated {
+				continue
+			}
+
+			slash.Record(ctx, slash.Change{
+				Kind:          slash.ChangeStatus,
+				SubjectID:     fmt.Sprint(issue.ID),
+				SubjectNumber: int(issue.Number),
+				ProjectNumber: project.ProjectNumber,
+				FromStatus:    project.FromStatus,
+			})
+		}
 	}
 
 	return nil
 }
 
-func (h *Handler) Help() string {
-	return "- `/review` set all attached issues to \"In Progress\", " +
-		"to override the status use: status=\"Custom In Review\" as command argument"
+func (h *Handler) Metadata() slash.Metadata {
+	return slash.Metadata{
+		Name:        Command,
+		Description: "set the status of all attached issues on every project they are assigned to",
+		Usage:       "/status status=<name>",
+		Arguments: []slash.Argument{
+			{Name: statusKey, Description: "the name of the status option to move the issues to", Required: true},
+		},
+		Examples: []string{"/status status=In Review"},
+	}
 }
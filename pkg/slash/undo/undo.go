@@ -0,0 +1,142 @@
+package undo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/shurcooL/githubv4"
+
+	"github.com/skarlso/caretaker/pkg/client"
+	"github.com/skarlso/caretaker/pkg/slash"
+)
+
+// Command defines the command this handler understands.
+const Command = "/undo"
+
+type Handler struct {
+	client client.Client
+}
+
+func NewHandler(client client.Client) *Handler {
+	return &Handler{
+		client: client,
+	}
+}
+
+var _ slash.Command = &Handler{}
+
+// Execute reverts the changes recorded in the most recent journal comment left by Caretaker.
+func (h *Handler) Execute(ctx context.Context, pullNumber int, actor string, _ ...string) error {
+	comments, err := h.client.Comments(ctx, pullNumber)
+	if err != nil {
+		return fmt.Errorf("failed to fetch comments: %w", err)
+	}
+
+	var (
+		comment client.Comment
+		journal *slash.Journal
+	)
+
+	for i := len(comments) - 1; i >= 0; i-- {
+		// Only trust journals written by Caretaker, otherwise anyone could forge changes.
+		if !comments[i].ViewerDidAuthor {
+			continue
+		}
+
+		j, ok, err := slash.ParseJournal(string(comments[i].Body))
+		if err != nil {
+			return fmt.Errorf("failed to parse journal: %w", err)
+		}
+
+		if ok {
+			comment, journal = comments[i], j
+
+			break
+		}
+	}
+
+	if journal == nil {
+		return errors.New("no changes found that could be undone")
+	}
+
+	pr, err := h.client.PullRequest(ctx, pullNumber)
+	if err != nil {
+		return fmt.Errorf("failed to get related pull request: %w", err)
+	}
+
+	var errs []error
+
+	// revert in reverse order to restore the state from before the first change
+	for i := len(journal.Changes) - 1; i >= 0; i-- {
+		if err := h.revert(ctx, pr, journal.Changes[i]); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Keep the journal if something failed so the undo can be retried. Reverting is idempotent.
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("failed to revert changes: %w", err)
+	}
+
+	if err := h.client.UpdateComment(ctx, comment.ID, slash.MarkReverted(string(comment.Body), actor)); err != nil {
+		return fmt.Errorf("failed to mark journal as reverted: %w", err)
+	}
+
+	return nil
+}
+
+func (h *Handler) revert(ctx context.Context, pr client.PullRequest, change slash.Change) error {
+	switch change.Kind {
+	case slash.ChangeLabel:
+		if err := h.client.RemoveLabel(ctx, change.Label, change.SubjectID); err != nil {
+			return fmt.Errorf("failed to remove label %s from %d: %w", change.Label, change.SubjectNumber, err)
+		}
+	case slash.ChangeAssignee:
+		if err := h.client.UnassignUserFromAssignable(ctx, change.UserID, change.SubjectID); err != nil {
+			return fmt.Errorf("failed to unassign user from %d: %w", change.SubjectNumber, err)
+		}
+	case slash.ChangeStatus:
+		for _, issue := range pr.ClosingIssuesReferences.Nodes {
+			if fmt.Sprint(issue.ID) != change.SubjectID {
+				continue
+			}
+
+			// the issue didn't have a Status before, so it's cleared
+			if change.FromStatus == "" {
+				if err := h.client.ClearIssueStatus(ctx, issue, change.ProjectNumber); err != nil {
+					return fmt.Errorf("failed to clear status of issue %d: %w", change.SubjectNumber, err)
+				}
+
+				return nil
+			}
+
+			if _, err := h.client.UpdateIssueStatus(
+				ctx,
+				issue,
+				githubv4.String(change.FromStatus),
+				change.ProjectNumber,
+			); err != nil {
+				return fmt.Errorf("failed to restore status of issue %d: %w", change.SubjectNumber, err)
+			}
+
+			return nil
+		}
+
+		return fmt.Errorf("issue %d is no longer linked to the pull request", change.SubjectNumber)
+	default:
+		return fmt.Errorf("unknown change kind %s", change.Kind)
+	}
+
+	return nil
+}
+
+func (h *Handler) Metadata() slash.Metadata {
+	return slash.Metadata{
+		Name: Command,
+		Description: "revert the status, label and assignee changes of the most recent command " +
+			"which hasn't been reverted yet",
+		Examples:     []string{"/undo"},
+		RequiredRole: githubv4.RepositoryPermissionWrite,
+	}
+}
@@ -0,0 +1,69 @@
+package undo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skarlso/caretaker/pkg/client"
+	"github.com/skarlso/caretaker/pkg/client/fakes"
+	"github.com/skarlso/caretaker/pkg/slash"
+)
+
+func TestHandler_Execute(t *testing.T) {
+	journal := &slash.Journal{
+		Commands: []string{"/status status=Done", "/label ready"},
+		Actor:    "skarlso",
+		Changes: []slash.Change{
+			{Kind: slash.ChangeStatus, SubjectID: "I_1", SubjectNumber: 1, ProjectNumber: 2, FromStatus: "In Progress"},
+			{Kind: slash.ChangeStatus, SubjectID: "I_1", SubjectNumber: 1, ProjectNumber: 3},
+			{Kind: slash.ChangeLabel, SubjectID: "PR_1", SubjectNumber: 3, Label: "ready"},
+		},
+	}
+	body, err := journal.Render()
+	require.NoError(t, err)
+
+	forged := &slash.Journal{Changes: []slash.Change{{Kind: slash.ChangeLabel, SubjectID: "PR_1", Label: "forged"}}}
+	forgedBody, err := forged.Render()
+	require.NoError(t, err)
+
+	f := &fakes.FakeClient{}
+	f.CommentsReturns([]client.Comment{
+		{ID: "IC_1", Body: githubv4.String(body), ViewerDidAuthor: true},
+		{ID: "IC_2", Body: githubv4.String(forgedBody)},
+	}, nil)
+	issue := client.Issue{ID: "I_1", Number: 1}
+	pr := client.PullRequest{ID: "PR_1"}
+	pr.ClosingIssuesReferences.Nodes = []client.Issue{issue}
+	f.PullRequestReturns(pr, nil)
+
+	require.NoError(t, NewHandler(f).Execute(context.Background(), 3, "maintainer"))
+
+	require.Equal(t, 1, f.RemoveLabelCallCount())
+	_, label, id := f.RemoveLabelArgsForCall(0)
+	assert.Equal(t, "ready", label)
+	assert.Equal(t, "PR_1", id)
+
+	require.Equal(t, 1, f.UpdateIssueStatusCallCount())
+	_, gotIssue, status, projectNumber := f.UpdateIssueStatusArgsForCall(0)
+	assert.Equal(t, issue, gotIssue)
+	assert.Equal(t, githubv4.String("In Progress"), status)
+	assert.Equal(t, 2, projectNumber)
+
+	// the issue didn't have a Status in project 3, so it's cleared
+	require.Equal(t, 1, f.ClearIssueStatusCallCount())
+	_, gotIssue, projectNumber = f.ClearIssueStatusArgsForCall(0)
+	assert.Equal(t, issue, gotIssue)
+	assert.Equal(t, 3, projectNumber)
+
+	require.Equal(t, 1, f.UpdateCommentCallCount())
+	_, commentID, updated := f.UpdateCommentArgsForCall(0)
+	assert.Equal(t, "IC_1", commentID)
+
+	_, ok, err := slash.ParseJournal(updated)
+	require.NoError(t, err)
+	assert.False(t, ok, "reverted journal must not be undone again")
+}
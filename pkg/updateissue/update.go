@@ -39,11 +39,16 @@ func (c *Assigner) Update(ctx context.Context) error {
 		return fmt.Errorf("failed to fetch issue: %w", err)
 	}
 
+	project, err := c.client.Project(ctx, c.ProjectNumber)
+	if err != nil {
+		return fmt.Errorf("failed to fetch project: %w", err)
+	}
+
 	var projectItem *client.ProjectV2Item
 
 	for _, item := range issue.ProjectItems.Nodes {
 		item := item
-		if item.Project.Number == githubv4.Int(c.ProjectNumber) {
+		if item.Project.ID == project.ID {
 			projectItem = &item
 
 			break
@@ -52,8 +57,9 @@ func (c *Assigner) Update(ctx context.Context) error {
 
 	if projectItem == nil {
 		return fmt.Errorf(
-			"project with id %d not found in the list of project associated to issue number %d",
-			c.ProjectNumber,
+			"project %s with number %d not found in the list of project associated to issue number %d",
+			project.Title,
+			project.Number,
 			c.IssueNumber)
 	}
 
@@ -69,9 +75,18 @@ func (c *Assigner) Update(ctx context.Context) error {
 		return nil
 	}
 
-	if _, err := c.client.UpdateIssueStatus(ctx, issue, githubv4.String(c.ToStatus), c.ProjectNumber); err != nil {
+	result, err := c.client.UpdateIssueStatus(ctx, issue, githubv4.String(c.ToStatus), c.ProjectNumber)
+	if err != nil {
 		return fmt.Errorf("failed to update issue with number %d to status %s: %w", c.IssueNumber, c.ToStatus, err)
 	}
 
+	for _, project := range result.Projects {
+		if project.Outcome == client.StatusSkippedNotRequested {
+			continue
+		}
+
+		c.log.Log("issue with number %d in project %d: %s", c.IssueNumber, project.ProjectNumber, project.Outcome)
+	}
+
 	return nil
 }
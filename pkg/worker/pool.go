@@ -0,0 +1,133 @@
+// Package worker runs mutations concurrently with bounded parallelism and collects
+// the errors of all tasks instead of aborting on the first one.
+package worker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/skarlso/caretaker/pkg/logger"
+)
+
+// Step is a single operation of a task.
+type Step func(ctx context.Context) error
+
+// Task is a unit of work, for example, everything done for a single pull request. Its steps run
+// in order and a failing step skips the remaining steps, so a label which marks a pull request as
+// processed is only added once the status updates before it succeeded.
+type Task struct {
+	Name  string
+	Steps []Step
+}
+
+// Failure is the error of a single task.
+type Failure struct {
+	Task string
+	Err  error
+}
+
+// Report is returned by Run if any of the tasks failed.
+type Report struct {
+	Total    int
+	Failures []Failure
+}
+
+func (r *Report) Error() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "%d of %d task(s) failed:", len(r.Failures), r.Total)
+
+	for _, f := range r.Failures {
+		fmt.Fprintf(&b, "\n- %s: %s", f.Task, f.Err)
+	}
+
+	return b.String()
+}
+
+// Unwrap returns the errors of the failed tasks, so errors.Is and errors.As work on the report.
+func (r *Report) Unwrap() []error {
+	result := make([]error, 0, len(r.Failures))
+	for _, f := range r.Failures {
+		result = append(result, f.Err)
+	}
+
+	return result
+}
+
+// Pool runs tasks with at most concurrency tasks at a time.
+type Pool struct {
+	concurrency int
+	log         logger.Logger
+}
+
+// NewPool creates a pool. A concurrency below one runs the tasks sequentially.
+func NewPool(log logger.Logger, concurrency int) *Pool {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	return &Pool{
+		concurrency: concurrency,
+		log:         log,
+	}
+}
+
+// Run runs all tasks and waits for them to finish. Failing tasks don't stop the other tasks.
+// It returns a *Report with the failures in the order of the tasks, or nil if all tasks succeeded.
+func (p *Pool) Run(ctx context.Context, tasks []Task) error {
+	errs := make([]error, len(tasks))
+	queue := make(chan int)
+
+	var wg sync.WaitGroup
+
+	for range min(p.concurrency, len(tasks)) {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := range queue {
+				errs[i] = p.run(ctx, tasks[i])
+			}
+		}()
+	}
+
+	for i := range tasks {
+		queue <- i
+	}
+
+	close(queue)
+	wg.Wait()
+
+	report := &Report{Total: len(tasks)}
+
+	for i, err := range errs {
+		if err != nil {
+			report.Failures = append(report.Failures, Failure{Task: tasks[i].Name, Err: err})
+		}
+	}
+
+	if len(report.Failures) == 0 {
+		return nil
+	}
+
+	return report
+}
+
+func (p *Pool) run(ctx context.Context, task Task) error {
+	for _, step := range task.Steps {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if err := step(ctx); err != nil {
+			p.log.Log("%s failed: %s", task.Name, err)
+
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skarlso/caretaker/pkg/logger"
+)
+
+func TestPool_Run(t *testing.T) {
+	errFailed := errors.New("failed")
+
+	var (
+		running, peak atomic.Int32
+		mu            sync.Mutex
+		order         = map[string][]string{}
+	)
+
+	step := func(task, name string, err error) Step {
+		return func(context.Context) error {
+			current := running.Add(1)
+			defer running.Add(-1)
+
+			for {
+				p := peak.Load()
+				if current <= p || peak.CompareAndSwap(p, current) {
+					break
+				}
+			}
+
+			mu.Lock()
+			order[task] = append(order[task], name)
+			mu.Unlock()
+
+			return err
+		}
+	}
+
+	var tasks []Task
+	for _, name := range []string{"a", "b", "c", "d", "e"} {
+		var err error
+		if name == "b" || name == "d" {
+			err = errFailed
+		}
+
+		tasks = append(tasks, Task{
+			Name:  name,
+			Steps: []Step{step(name, "status", err), step(name, "label", nil)},
+		})
+	}
+
+	err := NewPool(&logger.QuiteLogger{}, 2).Run(context.Background(), tasks)
+	require.Error(t, err)
+
+	var report *Report
+	require.ErrorAs(t, err, &report)
+	assert.Equal(t, 5, report.Total)
+	assert.Equal(t, []Failure{{Task: "b", Err: errFailed}, {Task: "d", Err: errFailed}}, report.Failures)
+	assert.ErrorIs(t, err, errFailed)
+
+	// the steps of a task run in order and stop at the first failure
+	assert.Equal(t, []string{"status", "label"}, order["a"])
+	assert.Equal(t, []string{"status"}, order["b"])
+	assert.Equal(t, []string{"status", "label"}, order["e"])
+	assert.LessOrEqual(t, peak.Load(), int32(2))
+
+	assert.NoError(t, NewPool(&logger.QuiteLogger{}, 0).Run(context.Background(), nil))
+}
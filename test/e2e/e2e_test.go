@@ -0,0 +1,418 @@
+// Package e2e runs the commands against the fake GitHub GraphQL API of the githubtest package.
+package e2e
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skarlso/caretaker/cmd"
+	"github.com/skarlso/caretaker/pkg/client"
+	"github.com/skarlso/caretaker/pkg/githubtest"
+)
+
+const (
+	owner = "skarlso"
+	repo  = "caretaker"
+	token = "secret"
+)
+
+// setup starts a server with a repository and a project with the number 1.
+func setup(t *testing.T) (*githubtest.Server, *githubtest.Repository, *githubtest.Project) {
+	t.Helper()
+
+	s := githubtest.NewServer()
+	t.Cleanup(s.Close)
+
+	s.Token = token
+
+	r := s.AddRepository(owner, repo)
+	p := s.AddProject(owner, 1, "board", "Todo", "In Progress", "In Review", "Done", "Closed")
+
+	return s, r, p
+}
+
+// run executes the command with the flags pointing it at the server.
+func run(s *githubtest.Server, command string, args ...string) error {
+	root := cmd.CreateRootCommand()
+	root.SilenceUsage = true
+	root.SetArgs(append([]string{
+		command,
+		"--token=" + token,
+		"--owner=" + owner,
+		"--repo=" + repo,
+		"--github-url=" + s.URL,
+	}, args...))
+
+	return root.Execute()
+}
+
+func TestScan(t *testing.T) {
+	s, r, p := setup(t)
+
+	issue := r.AddIssue("issue")
+	p.AddItem(issue).SetStatus("In Progress")
+
+	stale := r.AddPullRequest("stale", issue)
+	stale.UpdatedAt = time.Now().Add(-48 * time.Hour)
+
+	fresh := r.AddPullRequest("fresh", r.AddIssue("other issue"))
+
+	require.NoError(t, run(s, "scan", "--status-option=In Review", "--create-missing-labels=true"))
+
+	assert.Equal(t, "In Review", p.ItemOf(issue).Status)
+	assert.True(t, stale.HasLabel("caretaker-processed"))
+	assert.Len(t, stale.Comments, 1)
+	assert.False(t, fresh.HasLabel("caretaker-processed"))
+
+	// the processed pull request is skipped by the next scan
+	p.ItemOf(issue).SetStatus("In Progress")
+
+	require.NoError(t, run(s, "scan", "--status-option=In Review"))
+	assert.Equal(t, "In Progress", p.ItemOf(issue).Status)
+}
+
+func TestScanBatched(t *testing.T) {
+	s, r, p := setup(t)
+	r.AddLabel("caretaker-processed")
+
+	var issues []*githubtest.Issue
+
+	for range 5 {
+		issue := r.AddIssue("issue")
+		p.AddItem(issue).SetStatus("In Progress")
+		r.AddPullRequest("pull request", issue).UpdatedAt = time.Now().Add(-48 * time.Hour)
+
+		issues = append(issues, issue)
+	}
+
+	require.NoError(t, run(s, "scan", "--status-option=In Review", "--concurrency=5", "--batch-size=5"))
+
+	for _, issue := range issues {
+		assert.Equal(t, "In Review", p.ItemOf(issue).Status)
+	}
+
+	// the status updates, labels and comments of the pull requests are sent in fewer requests
+	assert.Len(t, s.Mutations(), 15)
+	assert.Less(t, s.MutationRequests(), 15)
+}
+
+func TestPullRequestUpdated(t *testing.T) {
+	s, r, p := setup(t)
+
+	issue := r.AddIssue("issue")
+	p.AddItem(issue).SetStatus("In Review")
+
+	pr := r.AddPullRequest("pull request", issue)
+	pr.Labels = append(pr.Labels, r.AddLabel("caretaker-processed"))
+
+	require.NoError(t, run(s, "pull-request-updated",
+		"--pull-request-number=2",
+		"--status-option=In Progress",
+		"--disable-comments=true",
+	))
+
+	assert.Equal(t, "In Progress", p.ItemOf(issue).Status)
+	assert.False(t, pr.HasLabel("caretaker-processed"))
+}
+
+func TestScanProject(t *testing.T) {
+	s, r, p := setup(t)
+
+	done := p.AddItem(r.AddIssue("done a while ago")).SetStatus("Done")
+	done.StatusUpdatedAt = time.Now().Add(-72 * time.Hour)
+
+	recent := p.AddItem(r.AddIssue("just done")).SetStatus("Done")
+
+	require.NoError(t, run(s, "scan-project",
+		"--project-number=1",
+		"--from-status-option=Done",
+		"--status-option=Closed",
+		"--scan-interval=48h",
+	))
+
+	assert.Equal(t, "Closed", done.Status)
+	assert.Equal(t, "Done", recent.Status)
+}
+
+func TestAssignIssue(t *testing.T) {
+	s, r, p := setup(t)
+
+	issue := r.AddIssue("issue")
+
+	require.NoError(t, run(s, "assign-issue", "--issue-number=1", "--project-number=1"))
+	assert.NotNil(t, p.ItemOf(issue))
+
+	err := run(s, "assign-issue", "--issue-number=1", "--project-number=2")
+	require.ErrorIs(t, err, client.ErrProjectNotFound)
+	assert.Equal(t, cmd.ExitNotFound, cmd.ExitCode(err))
+}
+
+func TestAssignIssueToSeveralProjects(t *testing.T) {
+	s, r, p := setup(t)
+	p.AddField("Priority", "SINGLE_SELECT", "Low", "High")
+	p.AddField("Estimate", "NUMBER")
+
+	rfcs := s.AddProject(owner, 2, "rfcs", "Proposed", "Accepted")
+	bugs := s.AddProject(owner, 3, "bugs", "Triage")
+
+	issue := r.AddIssue("[RFC] new status")
+	issue.Labels = append(issue.Labels, r.AddLabel("enhancement"))
+
+	config := filepath.Join(t.TempDir(), "caretaker.yaml")
+	require.NoError(t, os.WriteFile(config, []byte(`assignments:
+- project: 2
+  title: ^\[RFC\]
+  status: Proposed
+- project: 3
+  labels: [bug]
+  status: Triage
+`), 0o600))
+
+	args := []string{
+		"--issue-number=1",
+		"--project-number=1",
+		"--status-option=todo",
+		"--field-values=Priority=high,Estimate=3",
+		"--config=" + config,
+	}
+
+	require.NoError(t, run(s, "assign-issue", args...))
+
+	item := p.ItemOf(issue)
+	require.NotNil(t, item)
+	assert.Equal(t, "Todo", item.Status)
+	assert.Equal(t, map[string]string{"Priority": "High", "Estimate": "3"}, item.Values)
+	assert.Equal(t, "Proposed", rfcs.ItemOf(issue).Status)
+	assert.Nil(t, bugs.ItemOf(issue))
+
+	// projects which have the issue already are left alone, items without a Status get the initial one
+	item.SetStatus("In Progress")
+	rfcs.ItemOf(issue).Status = ""
+
+	require.NoError(t, run(s, "assign-issue", args...))
+	assert.Len(t, p.Items, 1)
+	assert.Equal(t, "In Progress", item.Status)
+	assert.Equal(t, "Proposed", rfcs.ItemOf(issue).Status)
+}
+
+func TestUpdateIssue(t *testing.T) {
+	s, r, p := setup(t)
+
+	issue := r.AddIssue("issue")
+	p.AddItem(issue).SetStatus("Todo")
+
+	require.NoError(t, run(s, "update-issue", "--issue-number=1", "--project-number=1", "--status-option=Done"))
+	assert.Equal(t, "Done", p.ItemOf(issue).Status)
+
+	err := run(s, "update-issue", "--issue-number=1", "--project-number=1", "--status-option=Dnoe", "--strict=true")
+	require.ErrorIs(t, err, client.ErrStatusOptionNotFound)
+	assert.Equal(t, "Done", p.ItemOf(issue).Status)
+}
+
+func TestUpdateIssueOfOrganization(t *testing.T) {
+	s, _, _ := setup(t)
+
+	s.AddOrganization("acme")
+	p := s.AddProject("acme", 1, "board", "Todo", "Done")
+	issue := s.AddRepository("acme", repo).AddIssue("issue")
+	p.AddItem(issue).SetStatus("Todo")
+
+	// any value which isn't false marks the owner as an organization, so it isn't looked up
+	require.NoError(t, run(s, "update-issue",
+		"--owner=acme",
+		"--is-organization=yes",
+		"--issue-number=1",
+		"--project-number=1",
+		"--status-option=Done",
+	))
+
+	assert.Equal(t, "Done", p.ItemOf(issue).Status)
+	assert.NotContains(t, s.Queries(), "repositoryOwner")
+}
+
+func TestSlash(t *testing.T) {
+	s, r, p := setup(t)
+
+	r.Collaborators["maintainer"] = "WRITE"
+	r.AddLabel("bug")
+
+	issue := r.AddIssue("issue")
+	p.AddItem(issue).SetStatus("Todo")
+
+	pr := r.AddPullRequest("pull request", issue)
+	comment := pr.AddComment("maintainer", "/status status=In Progress\n/label bug")
+
+	require.NoError(t, run(s, "slash",
+		"--pull-request-number=2",
+		"--actor=maintainer",
+		"--comment-id="+comment.ID,
+		"--comment-body="+comment.Body,
+	))
+
+	assert.Equal(t, "In Progress", p.ItemOf(issue).Status)
+	assert.True(t, pr.HasLabel("bug"))
+	assert.Contains(t, comment.Reactions["THUMBS_UP"], githubtest.DefaultViewer)
+
+	// undo restores the status the command has changed
+	undo := pr.AddComment("maintainer", "/undo")
+
+	require.NoError(t, run(s, "slash",
+		"--pull-request-number=2",
+		"--actor=maintainer",
+		"--comment-id="+undo.ID,
+		"--comment-body="+undo.Body,
+	))
+
+	assert.Equal(t, "Todo", p.ItemOf(issue).Status)
+	assert.False(t, pr.HasLabel("bug"))
+}
+
+func TestSlashUndoFirstStatus(t *testing.T) {
+	s, r, p := setup(t)
+
+	r.Collaborators["maintainer"] = "WRITE"
+
+	issue := r.AddIssue("issue")
+	p.AddItem(issue)
+
+	pr := r.AddPullRequest("pull request", issue)
+
+	for _, body := range []string{"/status status=In Progress", "/undo"} {
+		comment := pr.AddComment("maintainer", body)
+
+		require.NoError(t, run(s, "slash",
+			"--pull-request-number=2",
+			"--actor=maintainer",
+			"--comment-id="+comment.ID,
+			"--comment-body="+comment.Body,
+		))
+	}
+
+	// the item didn't have a Status before, so undo clears it
+	assert.Empty(t, p.ItemOf(issue).Status)
+	assert.Contains(t, s.Mutations(), "clearProjectV2ItemFieldValue")
+}
+
+func TestBadCredentials(t *testing.T) {
+	s, _, _ := setup(t)
+
+	s.Token = "rotated"
+
+	err := run(s, "scan", "--status-option=In Review")
+	require.ErrorIs(t, err, client.ErrForbidden)
+	assert.Equal(t, cmd.ExitForbidden, cmd.ExitCode(err))
+}
+
+func TestProjectOfOtherOwner(t *testing.T) {
+	s, r, p := setup(t)
+
+	s.AddOrganization("planning")
+	roadmap := s.AddProject("planning", 1, "roadmap", "Todo", "Done")
+
+	issue := r.AddIssue("issue")
+	p.AddItem(issue).SetStatus("Todo")
+
+	require.NoError(t, run(s, "assign-issue", "--issue-number=1", "--project-number=1", "--project-owner=planning"))
+	roadmap.ItemOf(issue).SetStatus("Todo")
+
+	// both projects have the number 1, only the project of the other owner is updated
+	require.NoError(t, run(s, "update-issue",
+		"--issue-number=1",
+		"--project-number=1",
+		"--project-owner=planning",
+		"--status-option=Done",
+	))
+
+	assert.Equal(t, "Done", roadmap.ItemOf(issue).Status)
+	assert.Equal(t, "Todo", p.ItemOf(issue).Status)
+
+	roadmap.ItemOf(issue).StatusUpdatedAt = time.Now().Add(-72 * time.Hour)
+	p.ItemOf(issue).SetStatus("Done")
+
+	require.NoError(t, run(s, "scan-project",
+		"--project-id="+roadmap.ID,
+		"--from-status-option=Done",
+		"--status-option=Todo",
+		"--scan-interval=48h",
+	))
+
+	assert.Equal(t, "Todo", roadmap.ItemOf(issue).Status)
+	assert.Equal(t, "Done", p.ItemOf(issue).Status)
+}
+
+func TestAssignIssueByProjectIDAndNumber(t *testing.T) {
+	s, r, p := setup(t)
+
+	s.AddOrganization("planning")
+	roadmap := s.AddProject("planning", 1, "roadmap", "Todo", "Done")
+
+	issue := r.AddIssue("issue")
+
+	config := filepath.Join(t.TempDir(), "caretaker.yaml")
+	require.NoError(t, os.WriteFile(config, []byte(`assignments:
+- project: 1
+  status: In Progress
+`), 0o600))
+
+	// the configured number addresses the project of the owner, the ID the project of the other owner
+	require.NoError(t, run(s, "assign-issue",
+		"--issue-number=1",
+		"--project-id="+roadmap.ID,
+		"--status-option=Todo",
+		"--config="+config,
+	))
+
+	assert.Equal(t, "In Progress", p.ItemOf(issue).Status)
+	assert.Equal(t, "Todo", roadmap.ItemOf(issue).Status)
+}
+
+func TestScanMultipleRepositories(t *testing.T) {
+	s, r, p := setup(t)
+
+	stalePullRequest := func(repository *githubtest.Repository) *githubtest.PullRequest {
+		issue := repository.AddIssue("issue")
+		p.AddItem(issue).SetStatus("In Progress")
+
+		pr := repository.AddPullRequest("pull request", issue)
+		pr.UpdatedAt = time.Now().Add(-48 * time.Hour)
+
+		return pr
+	}
+
+	skipped := stalePullRequest(r)
+
+	gateway := s.AddRepository(owner, "api-gateway")
+	gatewayPR := stalePullRequest(gateway)
+
+	archived := s.AddRepository(owner, "api-users")
+	archived.IsArchived = true
+
+	website := s.AddRepository(owner, "website")
+	website.Topics = []string{"caretaker"}
+	websitePR := stalePullRequest(website)
+
+	summary := filepath.Join(t.TempDir(), "summary.md")
+	t.Setenv("GITHUB_STEP_SUMMARY", summary)
+
+	require.NoError(t, run(s, "scan",
+		"--status-option=In Review",
+		"--create-missing-labels=true",
+		"--disable-comments=true",
+		"--repos=api-*",
+		"--repo-search=topic:caretaker",
+	))
+
+	assert.True(t, gatewayPR.HasLabel("caretaker-processed"))
+	assert.True(t, websitePR.HasLabel("caretaker-processed"))
+	assert.False(t, skipped.HasLabel("caretaker-processed"))
+
+	content, err := os.ReadFile(summary)
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "| api-gateway | 1 | 1 | 0 |  |\n| website | 1 | 1 | 0 |  |\n")
+	assert.NotContains(t, string(content), "api-users")
+}